@@ -16,17 +16,20 @@ const (
 	RegionNorthAmerica      Region = "na1"
 	RegionOceania           Region = "oc1"
 	RegionPBE               Region = "pbe1"
+	RegionRussia            Region = "ru"
+	// RegionSouthEastAsia is the SEA platform, which still uses the platform ID of SG2. PH2 and TH2 were merged
+	// into it on Jan. 8th, 2025. The deprecated constants below are aliases of it and can not be told apart.
+	RegionSouthEastAsia Region = "sg2"
 	// Deprecated: Use api.RegionSouthEastAsia instead. PH2 got merged into the SEA server on 8th of Jan, 2025.
-	RegionPhilippines Region = "sg2" // PH2 got merged into SG2 on Jan. 8th, 2025. Replaced for backwards compatibility.
-	RegionRussia      Region = "ru"
+	RegionPhilippines = RegionSouthEastAsia
 	// Deprecated: Use api.RegionSouthEastAsia instead. SG2 is now called SEA
-	RegionSingapore     Region = "sg2" // SG2 is now called SEA while still running on SG2.
-	RegionSouthEastAsia Region = "sg2"
+	RegionSingapore = RegionSouthEastAsia
 	// Deprecated: Use api.RegionSouthEastAsia instead. TH2 got merged into the SEA server on 8th of Jan, 2025.
-	RegionThailand Region = "sg2" // TH2 got merged into SG2 on Jan. 8th, 2025. Replaced for backwards compatibility.
-	RegionTurkey   Region = "tr1"
-	RegionTaiwan   Region = "tw2"
-	RegionVietnam  Region = "vn2"
+	RegionThailand = RegionSouthEastAsia
+
+	RegionTurkey  Region = "tr1"
+	RegionTaiwan  Region = "tw2"
+	RegionVietnam Region = "vn2"
 )
 
 // Route represents a server region's route
@@ -38,6 +41,8 @@ const (
 	RouteAsia     Route = "asia"
 	RouteEurope   Route = "europe"
 	RouteSEA      Route = "sea"
+	// RouteESPORTS serves accounts and VALORANT matches of esports events
+	RouteESPORTS Route = "esports"
 )
 
 var (
@@ -61,22 +66,6 @@ var (
 		RegionVietnam,
 	}
 
-	// RegionToRoute maps each region to its route
-	RegionToRoute = map[Region]Route{
-		RegionBrasil:            RouteAmericas,
-		RegionEuropeNorthEast:   RouteEurope,
-		RegionEuropeWest:        RouteEurope,
-		RegionJapan:             RouteAsia,
-		RegionKorea:             RouteAsia,
-		RegionLatinAmericaNorth: RouteAmericas,
-		RegionLatinAmericaSouth: RouteAmericas,
-		RegionMiddleEast:        RouteEurope,
-		RegionNorthAmerica:      RouteAmericas,
-		RegionOceania:           RouteSEA,
-		RegionRussia:            RouteEurope,
-		RegionSouthEastAsia:     RouteSEA,
-		RegionTurkey:            RouteEurope,
-		RegionTaiwan:            RouteSEA,
-		RegionVietnam:           RouteSEA,
-	}
+	// RegionToRoute maps each region to its route. Use Host to get the route used by the endpoints of a game.
+	RegionToRoute = regionToRoute()
 )
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Game identifies a game of the Riot API whose endpoints are routed differently
+type Game string
+
+// All games known to the region registry
+const (
+	GameLoL     Game = "lol"
+	GameTFT     Game = "tft"
+	GameVAL     Game = "val"
+	GameLoR     Game = "lor"
+	GameAccount Game = "account"
+)
+
+// EndpointFamily groups the endpoints of a game by the kind of host serving them
+type EndpointFamily int
+
+// All endpoint families
+const (
+	// FamilyPlatform endpoints are served by a platform, e.g. euw1 for the summoner endpoints. VALORANT serves
+	// them by shard and Legends of Runeterra by regional route.
+	FamilyPlatform EndpointFamily = iota
+	// FamilyRegional endpoints are served by a regional route, e.g. europe for match-v5
+	FamilyRegional
+)
+
+// ErrUnknownRegion is returned if a region name can not be parsed or a region can not be routed for a game
+var ErrUnknownRegion = errors.New("unknown region")
+
+// RegionInfo describes a platform region and the hosts serving it for each game
+type RegionInfo struct {
+	Region Region
+	// Name is the user facing name of the region, e.g. "EUW"
+	Name string
+	// Aliases are additional user facing names and platform IDs, e.g. of platforms merged into the region.
+	// Regions which are aliases of each other, like RegionPhilippines, RegionSingapore and RegionThailand of
+	// RegionSouthEastAsia, share one entry.
+	Aliases []string
+	// Route is the regional route of the region used by League of Legends and TFT
+	Route Route
+	// ValShard is the VALORANT shard serving the region
+	ValShard Region
+	// Realm is the name of the region in Data Dragon realm files
+	Realm string
+}
+
+// VALORANT shards, which are used as regions by all VALORANT endpoints
+const (
+	ValShardAsiaPacific  Region = "ap"
+	ValShardBrazil       Region = "br"
+	ValShardESPORTS      Region = "esports"
+	ValShardEurope       Region = "eu"
+	ValShardKorea        Region = "kr"
+	ValShardLatinAmerica Region = "latam"
+	ValShardNorthAmerica Region = "na"
+)
+
+// RegionRegistry contains all platform regions ordered as Regions
+var RegionRegistry = []RegionInfo{
+	{Region: RegionBrasil, Name: "BR", Route: RouteAmericas, ValShard: ValShardBrazil, Realm: "br"},
+	{Region: RegionEuropeNorthEast, Name: "EUNE", Route: RouteEurope, ValShard: ValShardEurope, Realm: "eun"},
+	{Region: RegionEuropeWest, Name: "EUW", Route: RouteEurope, ValShard: ValShardEurope, Realm: "euw"},
+	{Region: RegionJapan, Name: "JP", Route: RouteAsia, ValShard: ValShardAsiaPacific, Realm: "jp"},
+	{Region: RegionKorea, Name: "KR", Route: RouteAsia, ValShard: ValShardKorea, Realm: "kr"},
+	{Region: RegionLatinAmericaNorth, Name: "LAN", Route: RouteAmericas, ValShard: ValShardLatinAmerica, Realm: "lan"},
+	{Region: RegionLatinAmericaSouth, Name: "LAS", Route: RouteAmericas, ValShard: ValShardLatinAmerica, Realm: "las"},
+	{Region: RegionNorthAmerica, Name: "NA", Route: RouteAmericas, ValShard: ValShardNorthAmerica, Realm: "na"},
+	{Region: RegionMiddleEast, Name: "ME", Route: RouteEurope, ValShard: ValShardEurope, Realm: "me"},
+	{Region: RegionOceania, Name: "OCE", Route: RouteSEA, ValShard: ValShardAsiaPacific, Realm: "oce"},
+	{Region: RegionPBE, Name: "PBE", Route: RouteAmericas, ValShard: ValShardNorthAmerica, Realm: "pbe"},
+	{Region: RegionRussia, Name: "RU", Route: RouteEurope, ValShard: ValShardEurope, Realm: "ru"},
+	{
+		Region:   RegionSouthEastAsia,
+		Name:     "SEA",
+		Aliases:  []string{"PH", "PH2", "SG", "TH", "TH2"},
+		Route:    RouteSEA,
+		ValShard: ValShardAsiaPacific,
+		Realm:    "sea",
+	},
+	{Region: RegionTurkey, Name: "TR", Route: RouteEurope, ValShard: ValShardEurope, Realm: "tr"},
+	{Region: RegionTaiwan, Name: "TW", Route: RouteSEA, ValShard: ValShardAsiaPacific, Realm: "tw"},
+	{Region: RegionVietnam, Name: "VN", Route: RouteSEA, ValShard: ValShardAsiaPacific, Realm: "vn"},
+}
+
+// routes contains the regional routes of platform regions
+var routes = []Route{RouteAmericas, RouteAsia, RouteEurope, RouteSEA}
+
+// valShards maps each VALORANT shard to the regional route of its accounts
+var valShards = map[Region]Route{
+	ValShardAsiaPacific:  RouteAsia,
+	ValShardBrazil:       RouteAmericas,
+	ValShardESPORTS:      RouteESPORTS,
+	ValShardEurope:       RouteEurope,
+	ValShardKorea:        RouteAsia,
+	ValShardLatinAmerica: RouteAmericas,
+	ValShardNorthAmerica: RouteAmericas,
+}
+
+// LookupRegion returns the registry entry of the given platform region
+func LookupRegion(region Region) (RegionInfo, error) {
+	for _, info := range RegionRegistry {
+		if info.Region == region {
+			return info, nil
+		}
+	}
+	return RegionInfo{}, fmt.Errorf("%w: %s", ErrUnknownRegion, region)
+}
+
+// ParseRegion returns the platform region for a user facing name like "EUW" or "na", or a platform ID like
+// "euw1". Names are matched ignoring case. Names of merged platforms, e.g. "PH" or "th2", return the region they
+// were merged into.
+func ParseRegion(name string) (Region, error) {
+	name = strings.TrimSpace(name)
+	for _, info := range RegionRegistry {
+		if strings.EqualFold(info.Name, name) || strings.EqualFold(string(info.Region), name) {
+			return info.Region, nil
+		}
+		for _, alias := range info.Aliases {
+			if strings.EqualFold(alias, name) {
+				return info.Region, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownRegion, name)
+}
+
+// Host returns the platform, shard or route serving the endpoints of the given family of the game for the region.
+// The region may be a platform region, a regional route or a VALORANT shard. An error is returned if the region
+// can not be served for the game, e.g. a VALORANT shard for League of Legends.
+func Host(game Game, family EndpointFamily, region Region) (Region, error) {
+	if info, err := LookupRegion(region); err == nil {
+		return platformHost(game, family, &info)
+	}
+	if route, ok := lookupRoute(region); ok {
+		return routeHost(game, family, route)
+	}
+	if route, ok := valShards[region]; ok {
+		switch game {
+		case GameVAL:
+			return region, nil
+		case GameAccount:
+			return Region(route), nil
+		}
+	}
+	return "", hostError(game, region)
+}
+
+func platformHost(game Game, family EndpointFamily, info *RegionInfo) (Region, error) {
+	switch game {
+	case GameLoL, GameTFT:
+		if family == FamilyRegional {
+			return Region(info.Route), nil
+		}
+		return info.Region, nil
+	case GameVAL:
+		return info.ValShard, nil
+	case GameLoR:
+		return Region(lorRoute(info.Route)), nil
+	case GameAccount:
+		return Region(accountRoute(info.Route)), nil
+	default:
+		return "", fmt.Errorf("%w: unknown game %s", ErrUnknownRegion, game)
+	}
+}
+
+func routeHost(game Game, family EndpointFamily, route Route) (Region, error) {
+	switch game {
+	case GameLoL, GameTFT:
+		if family == FamilyRegional {
+			return Region(route), nil
+		}
+	case GameLoR:
+		return Region(lorRoute(route)), nil
+	case GameAccount:
+		return Region(accountRoute(route)), nil
+	}
+	return "", hostError(game, Region(route))
+}
+
+func hostError(game Game, region Region) error {
+	return fmt.Errorf("%w: %s can not be used for %s", ErrUnknownRegion, region, game)
+}
+
+func lookupRoute(region Region) (Route, bool) {
+	for _, route := range routes {
+		if Region(route) == region {
+			return route, true
+		}
+	}
+	return "", false
+}
+
+// accountRoute returns the route serving accounts. Account endpoints are not served by the sea route.
+func accountRoute(route Route) Route {
+	if route == RouteSEA {
+		return RouteAsia
+	}
+	return route
+}
+
+// lorRoute returns the route serving Legends of Runeterra. Asian players are served by the sea route.
+func lorRoute(route Route) Route {
+	if route == RouteAsia {
+		return RouteSEA
+	}
+	return route
+}
+
+func regionToRoute() map[Region]Route {
+	res := make(map[Region]Route, len(RegionRegistry))
+	for _, info := range RegionRegistry {
+		res[info.Region] = info.Route
+	}
+	return res
+}
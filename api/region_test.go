@@ -0,0 +1,107 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionRegistry(t *testing.T) {
+	t.Parallel()
+	require.Len(t, RegionRegistry, len(Regions))
+	for i, info := range RegionRegistry {
+		assert.Equal(t, Regions[i], info.Region)
+		assert.NotEmpty(t, info.Name)
+		assert.NotEmpty(t, info.Route)
+		assert.Contains(t, valShards, info.ValShard)
+		assert.NotEmpty(t, info.Realm)
+		assert.Equal(t, info.Route, RegionToRoute[info.Region])
+	}
+}
+
+func TestLookupRegion_MergedRegions(t *testing.T) {
+	t.Parallel()
+	for _, region := range []Region{RegionPhilippines, RegionSingapore, RegionThailand} {
+		info, err := LookupRegion(region)
+		require.NoError(t, err)
+		assert.Equal(t, RegionSouthEastAsia, info.Region)
+		assert.Equal(t, "SEA", info.Name)
+	}
+}
+
+func TestParseRegion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    Region
+		wantErr error
+	}{
+		{name: "EUW", want: RegionEuropeWest},
+		{name: "na", want: RegionNorthAmerica},
+		{name: " eune ", want: RegionEuropeNorthEast},
+		{name: "euw1", want: RegionEuropeWest},
+		{name: "TH", want: RegionSouthEastAsia},
+		{name: "ph2", want: RegionSouthEastAsia},
+		{name: "sg2", want: RegionSouthEastAsia},
+		{name: "PBE", want: RegionPBE},
+		{name: "EU", wantErr: ErrUnknownRegion},
+		{name: "", wantErr: ErrUnknownRegion},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				t.Parallel()
+				got, err := ParseRegion(tt.name)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestHost(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		game    Game
+		family  EndpointFamily
+		region  Region
+		want    Region
+		wantErr error
+	}{
+		{name: "lol platform", game: GameLoL, region: RegionEuropeWest, want: RegionEuropeWest},
+		{
+			name: "lol regional", game: GameLoL, family: FamilyRegional, region: RegionEuropeWest,
+			want: Region(RouteEurope),
+		},
+		{name: "lol pbe", game: GameLoL, family: FamilyRegional, region: RegionPBE, want: Region(RouteAmericas)},
+		{name: "tft route", game: GameTFT, family: FamilyRegional, region: Region(RouteSEA), want: Region(RouteSEA)},
+		{name: "tft platform by route", game: GameTFT, region: Region(RouteSEA), wantErr: ErrUnknownRegion},
+		{name: "lol val shard", game: GameLoL, region: ValShardEurope, wantErr: ErrUnknownRegion},
+		{name: "account sea", game: GameAccount, region: RegionVietnam, want: Region(RouteAsia)},
+		{name: "account sea route", game: GameAccount, region: Region(RouteSEA), want: Region(RouteAsia)},
+		{name: "account val shard", game: GameAccount, region: ValShardBrazil, want: Region(RouteAmericas)},
+		{name: "account esports", game: GameAccount, region: ValShardESPORTS, want: Region(RouteESPORTS)},
+		{name: "val platform", game: GameVAL, region: RegionTurkey, want: ValShardEurope},
+		{name: "val korea", game: GameVAL, region: RegionKorea, want: ValShardKorea},
+		{name: "val shard", game: GameVAL, region: ValShardLatinAmerica, want: ValShardLatinAmerica},
+		{name: "val esports", game: GameVAL, region: ValShardESPORTS, want: ValShardESPORTS},
+		{name: "val route", game: GameVAL, region: Region(RouteEurope), wantErr: ErrUnknownRegion},
+		{name: "lor asia", game: GameLoR, region: RegionJapan, want: Region(RouteSEA)},
+		{name: "lor route", game: GameLoR, region: Region(RouteAsia), want: Region(RouteSEA)},
+		{name: "lor shard", game: GameLoR, region: Region(RouteEurope), want: Region(RouteEurope)},
+		{name: "unknown game", game: "unknown", region: RegionEuropeWest, wantErr: ErrUnknownRegion},
+		{name: "unknown region", game: GameLoL, region: "xx1", wantErr: ErrUnknownRegion},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				t.Parallel()
+				got, err := Host(tt.game, tt.family, tt.region)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
@@ -0,0 +1,33 @@
+// Package apikey provides sources for the API key used to authenticate requests to the Riot API.
+// Besides a static key this includes keys which are refreshed at runtime, e.g. expiring development keys,
+// and pools spreading requests across several keys with independent rate limits.
+package apikey
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoKeyAvailable is returned if a provider has no usable key left
+var ErrNoKeyAvailable = errors.New("no api key available")
+
+// Provider provides the API key used for requests to the Riot API
+type Provider interface {
+	// Key returns the key to use for the next request
+	Key() (string, error)
+}
+
+// Reporter can be implemented by a Provider to be informed about failed requests made with one of its keys.
+// Report is called for rate limited (429), unauthorized (401) and forbidden (403) responses and returns true if
+// the request should be retried immediately with the next key returned by the provider.
+type Reporter interface {
+	Report(key string, statusCode int, retryAfter time.Duration) bool
+}
+
+// Static is a Provider always returning the same key
+type Static string
+
+// Key returns the static key
+func (s Static) Key() (string, error) {
+	return string(s), nil
+}
@@ -0,0 +1,14 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatic_Key(t *testing.T) {
+	t.Parallel()
+	key, err := Static("RGAPI-key").Key()
+	assert.Nil(t, err)
+	assert.Equal(t, "RGAPI-key", key)
+}
@@ -0,0 +1,130 @@
+package apikey
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Pool is a Provider which spreads requests across several keys. Each key has its own rate limit: a key
+// which was rate limited is skipped until its Retry-After duration passed. Keys rejected with 401 or 403
+// are benched and not used again until restored.
+type Pool struct {
+	now   func() time.Time
+	sleep func(time.Duration)
+	mu    sync.Mutex
+	keys  []*pooledKey
+	next  int
+}
+
+type pooledKey struct {
+	key          string
+	limitedUntil time.Time
+	benched      bool
+}
+
+// NewPool returns a new pool for the given keys
+func NewPool(keys ...string) *Pool {
+	p := &Pool{
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+	for _, key := range keys {
+		p.keys = append(p.keys, &pooledKey{key: key})
+	}
+	return p
+}
+
+// Key returns the next key which is neither benched nor rate limited. If all remaining keys are rate limited,
+// Key waits until the first of them becomes available again.
+func (p *Pool) Key() (string, error) {
+	for {
+		p.mu.Lock()
+		now := p.now()
+		var earliest *pooledKey
+		for i := 0; i < len(p.keys); i++ {
+			k := p.keys[(p.next+i)%len(p.keys)]
+			if k.benched {
+				continue
+			}
+			if !now.Before(k.limitedUntil) {
+				p.next = (p.next + i + 1) % len(p.keys)
+				p.mu.Unlock()
+				return k.key, nil
+			}
+			if earliest == nil || k.limitedUntil.Before(earliest.limitedUntil) {
+				earliest = k
+			}
+		}
+		p.mu.Unlock()
+		if earliest == nil {
+			return "", ErrNoKeyAvailable
+		}
+		p.sleep(earliest.limitedUntil.Sub(now))
+	}
+}
+
+// Report marks a rate limited key as unavailable for the retry duration and benches rejected keys.
+// It returns true if another key is left to retry the request with.
+func (p *Pool) Report(key string, statusCode int, retryAfter time.Duration) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	k := p.find(key)
+	if k == nil {
+		return false
+	}
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		k.limitedUntil = p.now().Add(retryAfter)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		k.benched = true
+	default:
+		return false
+	}
+	for _, other := range p.keys {
+		if !other.benched {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds a new key to the pool
+func (p *Pool) Add(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.find(key) == nil {
+		p.keys = append(p.keys, &pooledKey{key: key})
+	}
+}
+
+// Restore makes a benched key available again
+func (p *Pool) Restore(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k := p.find(key); k != nil {
+		k.benched = false
+	}
+}
+
+// Benched returns all keys which are currently benched
+func (p *Pool) Benched() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var res []string
+	for _, k := range p.keys {
+		if k.benched {
+			res = append(res, k.key)
+		}
+	}
+	return res
+}
+
+func (p *Pool) find(key string) *pooledKey {
+	for _, k := range p.keys {
+		if k.key == key {
+			return k
+		}
+	}
+	return nil
+}
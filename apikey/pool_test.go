@@ -0,0 +1,64 @@
+package apikey
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_Key(t *testing.T) {
+	t.Parallel()
+	p := NewPool("a", "b", "c")
+	var got []string
+	for i := 0; i < 4; i++ {
+		key, err := p.Key()
+		require.Nil(t, err)
+		got = append(got, key)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, got)
+}
+
+func TestPool_Report(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	p := NewPool("a", "b")
+	p.now = func() time.Time { return now }
+	var slept time.Duration
+	p.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+
+	assert.True(t, p.Report("a", http.StatusTooManyRequests, 10*time.Second))
+	key, _ := p.Key()
+	assert.Equal(t, "b", key)
+	key, _ = p.Key()
+	assert.Equal(t, "b", key)
+
+	assert.True(t, p.Report("b", http.StatusTooManyRequests, 20*time.Second))
+	key, _ = p.Key()
+	assert.Equal(t, "a", key)
+	assert.Equal(t, 10*time.Second, slept)
+
+	assert.False(t, p.Report("a", http.StatusNotFound, 0))
+	assert.False(t, p.Report("unknown", http.StatusForbidden, 0))
+	assert.True(t, p.Report("a", http.StatusForbidden, 0))
+	assert.Equal(t, []string{"a"}, p.Benched())
+	assert.False(t, p.Report("b", http.StatusUnauthorized, 0))
+	_, err := p.Key()
+	assert.Equal(t, ErrNoKeyAvailable, err)
+
+	p.Restore("b")
+	p.Add("c")
+	p.Add("c")
+	// b is still rate limited
+	key, _ = p.Key()
+	assert.Equal(t, "c", key)
+	now = now.Add(10 * time.Second)
+	key, _ = p.Key()
+	assert.Equal(t, "b", key)
+	assert.Equal(t, []string{"a"}, p.Benched())
+}
@@ -0,0 +1,77 @@
+package apikey
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Refreshing is a Provider which loads its key using a function and refreshes it after a given time
+// or when the current key is rejected by the API
+type Refreshing struct {
+	fetch   func() (string, error)
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	key     string
+	expires time.Time
+}
+
+// NewRefreshing returns a new Refreshing provider. The key is loaded using fetch on first use and reloaded
+// once ttl has passed. A ttl of 0 only reloads the key when it is rejected.
+func NewRefreshing(fetch func() (string, error), ttl time.Duration) *Refreshing {
+	return &Refreshing{
+		fetch: fetch,
+		ttl:   ttl,
+		now:   time.Now,
+	}
+}
+
+// Key returns the current key, loading a new one if necessary
+func (r *Refreshing) Key() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.key == "" || (r.ttl > 0 && !r.now().Before(r.expires)) {
+		if err := r.refresh(); err != nil {
+			return "", err
+		}
+	}
+	return r.key, nil
+}
+
+// Refresh loads a new key immediately
+func (r *Refreshing) Refresh() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.refresh()
+}
+
+// Report reloads the key if the given key was rejected. It returns true if a different key was loaded.
+func (r *Refreshing) Report(key string, statusCode int, _ time.Duration) bool {
+	if statusCode != http.StatusUnauthorized && statusCode != http.StatusForbidden {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if key != r.key {
+		// the key was already replaced by a concurrent request
+		return true
+	}
+	if err := r.refresh(); err != nil {
+		return false
+	}
+	return r.key != key
+}
+
+func (r *Refreshing) refresh() error {
+	key, err := r.fetch()
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		return ErrNoKeyAvailable
+	}
+	r.key = key
+	r.expires = r.now().Add(r.ttl)
+	return nil
+}
@@ -0,0 +1,119 @@
+package apikey
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshing_Key(t *testing.T) {
+	t.Parallel()
+	calls := 0
+	r := NewRefreshing(
+		func() (string, error) {
+			calls++
+			return fmt.Sprintf("key-%d", calls), nil
+		}, time.Hour,
+	)
+	now := time.Now()
+	r.now = func() time.Time { return now }
+	key, err := r.Key()
+	require.Nil(t, err)
+	assert.Equal(t, "key-1", key)
+	key, _ = r.Key()
+	assert.Equal(t, "key-1", key)
+	now = now.Add(time.Hour)
+	key, _ = r.Key()
+	assert.Equal(t, "key-2", key)
+	require.Nil(t, r.Refresh())
+	key, _ = r.Key()
+	assert.Equal(t, "key-3", key)
+}
+
+func TestRefreshing_Report(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		keys       []string
+		reportKey  string
+		statusCode int
+		want       bool
+		wantKey    string
+	}{
+		{
+			name:       "rejected key is replaced",
+			keys:       []string{"old", "new"},
+			reportKey:  "old",
+			statusCode: http.StatusForbidden,
+			want:       true,
+			wantKey:    "new",
+		},
+		{
+			name:       "same key returned",
+			keys:       []string{"old", "old"},
+			reportKey:  "old",
+			statusCode: http.StatusUnauthorized,
+			want:       false,
+			wantKey:    "old",
+		},
+		{
+			name:       "already replaced",
+			keys:       []string{"new"},
+			reportKey:  "old",
+			statusCode: http.StatusUnauthorized,
+			want:       true,
+			wantKey:    "new",
+		},
+		{
+			name:       "rate limit is ignored",
+			keys:       []string{"old", "new"},
+			reportKey:  "old",
+			statusCode: http.StatusTooManyRequests,
+			want:       false,
+			wantKey:    "old",
+		},
+		{
+			name:       "fetch fails",
+			keys:       []string{"old", ""},
+			reportKey:  "old",
+			statusCode: http.StatusUnauthorized,
+			want:       false,
+			wantKey:    "old",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				i := 0
+				r := NewRefreshing(
+					func() (string, error) {
+						key := tt.keys[i]
+						if i < len(tt.keys)-1 {
+							i++
+						}
+						return key, nil
+					}, 0,
+				)
+				_, err := r.Key()
+				require.Nil(t, err)
+				assert.Equal(t, tt.want, r.Report(tt.reportKey, tt.statusCode, 0))
+				key, _ := r.Key()
+				assert.Equal(t, tt.wantKey, key)
+			},
+		)
+	}
+}
+
+func TestRefreshing_KeyError(t *testing.T) {
+	t.Parallel()
+	r := NewRefreshing(func() (string, error) { return "", fmt.Errorf("error") }, 0)
+	_, err := r.Key()
+	assert.Error(t, err)
+	r = NewRefreshing(func() (string, error) { return "", nil }, 0)
+	_, err = r.Key()
+	assert.Equal(t, ErrNoKeyAvailable, err)
+}
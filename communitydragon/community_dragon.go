@@ -0,0 +1,367 @@
+// Package communitydragon provides methods for retrieving data from the Community Dragon CDN.
+// It complements Data Dragon with data which is only part of the game files, e.g. detailed ability values,
+// the modern rune descriptions, Teamfight Tactics sets and Arena augments.
+package communitydragon
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// Client provides access to the data of a single version and locale of the Community Dragon CDN.
+// Data is fetched on the first call to each method and cached for further calls.
+type Client struct {
+	logger        log.FieldLogger
+	Version       string
+	Locale        string
+	client        internal.Doer
+	baseURL       string
+	championsMu   sync.RWMutex
+	championBins  map[string]ChampionBin
+	perksMu       sync.RWMutex
+	perks         []Perk
+	perkStylesMu  sync.RWMutex
+	perkStyles    []PerkStyle
+	tftMu         sync.RWMutex
+	tft           *TFTData
+	arenaMu       sync.RWMutex
+	arenaAugments []ArenaAugment
+}
+
+// Option is used to alter the attributes of a Community Dragon client
+type Option func(*Client)
+
+// WithBaseURL sets the base URL used for all requests instead of DefaultBaseURL, e.g. to use a caching proxy
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithVersion sets the version of the data instead of VersionLatest. Game versions like 14.1.553.1234 are
+// shortened to the major.minor version used by Community Dragon.
+func WithVersion(version string) Option {
+	return func(c *Client) {
+		c.Version = normalizeVersion(version)
+	}
+}
+
+// WithLocale sets the locale of the data instead of LocaleDefault, e.g. "de_de". Data Dragon language codes like
+// de_DE are accepted as well.
+func WithLocale(locale string) Option {
+	return func(c *Client) {
+		c.Locale = strings.ToLower(locale)
+	}
+}
+
+// NewClient returns a new client for the Community Dragon CDN
+func NewClient(client internal.Doer, logger log.FieldLogger, options ...Option) *Client {
+	c := &Client{
+		client:  client,
+		logger:  logger.WithField("client", "community dragon"),
+		baseURL: DefaultBaseURL,
+		Version: VersionLatest,
+		Locale:  LocaleDefault,
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+	c.championBins = map[string]ChampionBin{}
+	return c
+}
+
+// ForVersion returns a client for the given version with the locale and settings of this client
+func (c *Client) ForVersion(version string) *Client {
+	return c.derive(normalizeVersion(version), c.Locale)
+}
+
+// ForLocale returns a client for the given locale with the version and settings of this client
+func (c *Client) ForLocale(locale string) *Client {
+	return c.derive(c.Version, strings.ToLower(locale))
+}
+
+func (c *Client) derive(version, locale string) *Client {
+	return &Client{
+		logger:       c.logger,
+		Version:      version,
+		Locale:       locale,
+		client:       c.client,
+		baseURL:      c.baseURL,
+		championBins: map[string]ChampionBin{},
+	}
+}
+
+// GetChampionBin returns the game data of the champion with the given Data Dragon ID, e.g. "Aatrox"
+func (c *Client) GetChampionBin(id string) (ChampionBin, error) {
+	id = strings.ToLower(id)
+	unlock, toggle := internal.RWLockToggle(&c.championsMu)
+	defer unlock()
+	bin, ok := c.championBins[id]
+	if !ok {
+		toggle()
+		if err := c.getInto(fmt.Sprintf(championBinPathFormat, id), &bin); err != nil {
+			return nil, err
+		}
+		c.championBins[id] = bin
+	}
+	return bin, nil
+}
+
+// GetChampionSpell returns the spell with the given script name, e.g. "AatroxQ", of the champion with the given
+// Data Dragon ID
+func (c *Client) GetChampionSpell(id, scriptName string) (SpellObject, error) {
+	bin, err := c.GetChampionBin(id)
+	if err != nil {
+		return SpellObject{}, err
+	}
+	return bin.Spell(scriptName)
+}
+
+// GetPerks returns all existing perks
+func (c *Client) GetPerks() ([]Perk, error) {
+	unlock, toggle := internal.RWLockToggle(&c.perksMu)
+	defer unlock()
+	if len(c.perks) < 1 {
+		toggle()
+		var res []Perk
+		if err := c.getInto(c.clientDataPath()+perksPath, &res); err != nil {
+			return nil, err
+		}
+		c.perks = res
+	}
+	res := make([]Perk, len(c.perks))
+	copy(res, c.perks)
+	return res, nil
+}
+
+// GetPerk returns the perk with the given id, e.g. 8005 for Press the Attack
+func (c *Client) GetPerk(id int) (Perk, error) {
+	perks, err := c.GetPerks()
+	if err != nil {
+		return Perk{}, err
+	}
+	for _, perk := range perks {
+		if perk.ID == id {
+			return perk, nil
+		}
+	}
+	return Perk{}, api.ErrNotFound
+}
+
+// GetPerkStyles returns all existing perk styles
+func (c *Client) GetPerkStyles() ([]PerkStyle, error) {
+	unlock, toggle := internal.RWLockToggle(&c.perkStylesMu)
+	defer unlock()
+	if len(c.perkStyles) < 1 {
+		toggle()
+		var res perkStylesResponse
+		if err := c.getInto(c.clientDataPath()+perkStylesPath, &res); err != nil {
+			return nil, err
+		}
+		c.perkStyles = res.Styles
+	}
+	res := make([]PerkStyle, len(c.perkStyles))
+	copy(res, c.perkStyles)
+	return res, nil
+}
+
+// GetPerkStyle returns the perk style with the given id, e.g. 8000 for Precision
+func (c *Client) GetPerkStyle(id int) (PerkStyle, error) {
+	styles, err := c.GetPerkStyles()
+	if err != nil {
+		return PerkStyle{}, err
+	}
+	for _, style := range styles {
+		if style.ID == id {
+			return style, nil
+		}
+	}
+	return PerkStyle{}, api.ErrNotFound
+}
+
+// GetTFTData returns all Teamfight Tactics items and sets. The data is cached and shared by all callers, so it
+// must not be modified.
+func (c *Client) GetTFTData() (*TFTData, error) {
+	unlock, toggle := internal.RWLockToggle(&c.tftMu)
+	defer unlock()
+	if c.tft == nil {
+		toggle()
+		var res TFTData
+		if err := c.getInto(fmt.Sprintf(tftPathFormat, c.Locale), &res); err != nil {
+			return nil, err
+		}
+		c.tft = &res
+	}
+	return c.tft, nil
+}
+
+// GetTFTSets returns all Teamfight Tactics sets and game modes
+func (c *Client) GetTFTSets() ([]TFTSet, error) {
+	data, err := c.GetTFTData()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]TFTSet, len(data.SetData))
+	copy(res, data.SetData)
+	return res, nil
+}
+
+// GetTFTSet returns the Teamfight Tactics set with the given mutator, e.g. "TFTSet9_2"
+func (c *Client) GetTFTSet(mutator string) (TFTSet, error) {
+	data, err := c.GetTFTData()
+	if err != nil {
+		return TFTSet{}, err
+	}
+	return data.Set(mutator)
+}
+
+// GetTFTTrait returns the Teamfight Tactics trait with the given API name, e.g. "Set9_Sorcerer", including its
+// breakpoints
+func (c *Client) GetTFTTrait(apiName string) (TFTTrait, error) {
+	data, err := c.GetTFTData()
+	if err != nil {
+		return TFTTrait{}, err
+	}
+	return data.Trait(apiName)
+}
+
+// GetTFTItems returns all Teamfight Tactics items, including augments and emblems
+func (c *Client) GetTFTItems() ([]TFTItem, error) {
+	data, err := c.GetTFTData()
+	if err != nil {
+		return nil, err
+	}
+	res := make([]TFTItem, len(data.Items))
+	copy(res, data.Items)
+	return res, nil
+}
+
+// GetTFTItem returns the Teamfight Tactics item with the given API name, e.g. "TFT_Item_BFSword"
+func (c *Client) GetTFTItem(apiName string) (TFTItem, error) {
+	data, err := c.GetTFTData()
+	if err != nil {
+		return TFTItem{}, err
+	}
+	return data.Item(apiName)
+}
+
+// GetArenaAugments returns all augments of the Arena game mode
+func (c *Client) GetArenaAugments() ([]ArenaAugment, error) {
+	unlock, toggle := internal.RWLockToggle(&c.arenaMu)
+	defer unlock()
+	if len(c.arenaAugments) < 1 {
+		toggle()
+		var res arenaResponse
+		if err := c.getInto(fmt.Sprintf(arenaPathFormat, c.Locale), &res); err != nil {
+			return nil, err
+		}
+		c.arenaAugments = res.Augments
+	}
+	res := make([]ArenaAugment, len(c.arenaAugments))
+	copy(res, c.arenaAugments)
+	return res, nil
+}
+
+// GetArenaAugment returns the Arena augment with the given id as used by the playerAugment fields of a match
+func (c *Client) GetArenaAugment(id int) (ArenaAugment, error) {
+	augments, err := c.GetArenaAugments()
+	if err != nil {
+		return ArenaAugment{}, err
+	}
+	for _, augment := range augments {
+		if augment.ID == id {
+			return augment, nil
+		}
+	}
+	return ArenaAugment{}, api.ErrNotFound
+}
+
+// AssetURL returns the URL of an asset path as used in the data of this package. Client asset paths like
+// "/lol-game-data/assets/v1/perk-images/..." and game asset paths like "ASSETS/Characters/.../Icon.tex" are
+// supported. Textures are served as PNG by Community Dragon.
+func (c *Client) AssetURL(path string) string {
+	path = strings.ToLower(path)
+	if strings.HasPrefix(path, clientAssetPrefix) {
+		return c.url(fmt.Sprintf(clientDataPathFormat, "default") + strings.TrimPrefix(path, clientAssetPrefix))
+	}
+	for _, ext := range []string{".tex", ".dds"} {
+		if strings.HasSuffix(path, ext) {
+			path = strings.TrimSuffix(path, ext) + ".png"
+		}
+	}
+	return c.url(gameDataPath + "/" + strings.TrimPrefix(path, "/"))
+}
+
+// ClearCaches resets all caches of the community dragon client
+func (c *Client) ClearCaches() {
+	c.championsMu.Lock()
+	c.championBins = map[string]ChampionBin{}
+	c.championsMu.Unlock()
+	c.perksMu.Lock()
+	c.perks = []Perk{}
+	c.perksMu.Unlock()
+	c.perkStylesMu.Lock()
+	c.perkStyles = []PerkStyle{}
+	c.perkStylesMu.Unlock()
+	c.tftMu.Lock()
+	c.tft = nil
+	c.tftMu.Unlock()
+	c.arenaMu.Lock()
+	c.arenaAugments = []ArenaAugment{}
+	c.arenaMu.Unlock()
+}
+
+// clientDataPath returns the path of the League client data for the locale of the client
+func (c *Client) clientDataPath() string {
+	locale := c.Locale
+	if locale == LocaleDefault {
+		locale = "default"
+	}
+	return fmt.Sprintf(clientDataPathFormat, locale)
+}
+
+func (c *Client) url(path string) string {
+	return c.baseURL + "/" + c.Version + path
+}
+
+func (c *Client) getInto(path string, target any) error {
+	request, err := http.NewRequest("GET", c.url(path), nil)
+	if err != nil {
+		return err
+	}
+	response, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+	if response.Body != nil {
+		defer response.Body.Close()
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		apiErr, ok := api.StatusToError[response.StatusCode]
+		if !ok {
+			apiErr = api.Error{
+				Message:    api.ErrMsgUnknown,
+				StatusCode: response.StatusCode,
+			}
+		}
+		return apiErr
+	}
+	return json.NewDecoder(response.Body).Decode(target)
+}
+
+// normalizeVersion shortens a game version to the major.minor version used by Community Dragon
+func normalizeVersion(version string) string {
+	version = strings.ToLower(version)
+	if parts := strings.Split(version, "."); len(parts) > 2 {
+		return parts[0] + "." + parts[1]
+	}
+	return version
+}
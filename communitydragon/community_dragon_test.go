@@ -0,0 +1,307 @@
+package communitydragon
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// pathDoer serves the JSON representation of the object with the longest matching path suffix and records all
+// requested URLs
+type pathDoer struct {
+	responses map[string]any
+	requested []string
+}
+
+func (d *pathDoer) Do(r *http.Request) (*http.Response, error) {
+	d.requested = append(d.requested, r.URL.String())
+	for suffix, object := range d.responses {
+		if strings.HasSuffix(r.URL.Path, suffix) {
+			return mock.NewJSONMockDoer(object, http.StatusOK).Do(r)
+		}
+	}
+	return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+	c := NewClient(http.DefaultClient, log.StandardLogger())
+	assert.Equal(t, VersionLatest, c.Version)
+	assert.Equal(t, LocaleDefault, c.Locale)
+	c = NewClient(
+		http.DefaultClient, log.StandardLogger(), WithVersion("14.1.553.1234"), WithLocale("de_DE"),
+		WithBaseURL("http://localhost/cd/"),
+	)
+	assert.Equal(t, "14.1", c.Version)
+	assert.Equal(t, "de_de", c.Locale)
+	assert.Equal(t, "http://localhost/cd", c.baseURL)
+}
+
+func TestClient_ForVersionAndLocale(t *testing.T) {
+	t.Parallel()
+	doer := &pathDoer{responses: map[string]any{"/v1/perks.json": []Perk{{ID: 8005}}}}
+	c := NewClient(doer, log.StandardLogger())
+	_, err := c.GetPerks()
+	require.Nil(t, err)
+	_, err = c.ForVersion(VersionPBE).GetPerks()
+	require.Nil(t, err)
+	_, err = c.ForLocale("ko_KR").ForVersion("13.24.1").GetPerks()
+	require.Nil(t, err)
+	assert.Equal(
+		t, []string{
+			DefaultBaseURL + "/latest/plugins/rcp-be-lol-game-data/global/default/v1/perks.json",
+			DefaultBaseURL + "/pbe/plugins/rcp-be-lol-game-data/global/default/v1/perks.json",
+			DefaultBaseURL + "/13.24/plugins/rcp-be-lol-game-data/global/ko_kr/v1/perks.json",
+		}, doer.requested,
+	)
+}
+
+func TestClient_getIntoClosesBody(t *testing.T) {
+	t.Parallel()
+	body := &mock.ResponseBody{Content: []byte(`{}`)}
+	doer := &mock.Doer{Response: http.Response{StatusCode: http.StatusNotFound}, ResponseBody: body}
+	_, err := NewClient(doer, log.StandardLogger()).GetPerks()
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = body.Read(make([]byte, 1))
+	assert.Equal(t, mock.ErrBodyClosed, err)
+}
+
+func TestClient_GetChampionBin(t *testing.T) {
+	t.Parallel()
+	bin := map[string]any{
+		"Characters/Aatrox/Spells/AatroxQAbility/AatroxQ": map[string]any{
+			"__type":      "SpellObject",
+			"mScriptName": "AatroxQ",
+			"mSpell": map[string]any{
+				"cooldownTime": []float64{14, 14, 12, 10, 8, 6, 6},
+				"mDataValues": []map[string]any{
+					{"mName": "QBaseDamage", "mValues": []float64{0, 10, 30, 50, 70, 90, 110}},
+				},
+			},
+		},
+		"Characters/Aatrox/CharacterRecords/Root": map[string]any{"__type": "CharacterRecord"},
+	}
+	doer := &pathDoer{responses: map[string]any{"/aatrox/aatrox.bin.json": bin}}
+	c := NewClient(doer, log.StandardLogger())
+	got, err := c.GetChampionBin("Aatrox")
+	require.Nil(t, err)
+	assert.Len(t, got, 2)
+	spell, err := c.GetChampionSpell("aatrox", "aatroxq")
+	require.Nil(t, err)
+	assert.Equal(t, []float64{14, 14, 12, 10, 8, 6, 6}, spell.Spell.CooldownTime)
+	damage, err := spell.Spell.DataValue("QBaseDamage")
+	require.Nil(t, err)
+	assert.Equal(t, 50.0, damage[3])
+	_, err = spell.Spell.DataValue("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetChampionSpell("Aatrox", "AatroxW")
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Equal(
+		t, []string{DefaultBaseURL + "/latest/game/data/characters/aatrox/aatrox.bin.json"}, doer.requested,
+	)
+	_, err = c.GetChampionBin("Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetPerks(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    *mock.Doer
+		want    Perk
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer([]Perk{{ID: 8005, Name: "Press the Attack"}}, http.StatusOK),
+			want: Perk{ID: 8005, Name: "Press the Attack"},
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+		{
+			name: "unknown error",
+			doer: mock.NewStatusMockDoer(999),
+			wantErr: api.Error{
+				Message:    "unknown error reason",
+				StatusCode: 999,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(tt.doer, log.StandardLogger())
+				got, err := c.GetPerk(8005)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+				if tt.wantErr == nil {
+					_, err = c.GetPerk(1)
+					assert.Equal(t, api.ErrNotFound, err)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_GetPerkStyles(t *testing.T) {
+	t.Parallel()
+	doer := &pathDoer{
+		responses: map[string]any{
+			"/v1/perkstyles.json": json.RawMessage(
+				`{"schemaVersion":2,"styles":[{"id":8000,"name":"Precision",` +
+					`"slots":[{"type":"kKeyStone","perks":[8005,8008]}]}]}`,
+			),
+		},
+	}
+	c := NewClient(doer, log.StandardLogger())
+	styles, err := c.GetPerkStyles()
+	require.Nil(t, err)
+	require.Len(t, styles, 1)
+	style, err := c.GetPerkStyle(8000)
+	require.Nil(t, err)
+	assert.Equal(t, "Precision", style.Name)
+	assert.Equal(t, []int{8005, 8008}, style.Slots[0].Perks)
+	_, err = c.GetPerkStyle(8100)
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Len(t, doer.requested, 1)
+}
+
+func TestClient_GetTFTData(t *testing.T) {
+	t.Parallel()
+	data := TFTData{
+		Items: []TFTItem{{APIName: "TFT_Item_BFSword", Name: "B.F. Sword"}},
+		SetData: []TFTSet{
+			{
+				Mutator: "TFTSet9_2",
+				Number:  9,
+				Champions: []TFTUnit{
+					{APIName: "TFT9_Ahri", Cost: 2, Traits: []string{"Set9_Sorcerer"}},
+				},
+				Traits: []TFTTrait{
+					{APIName: "Set9_Sorcerer", Effects: []TFTTraitEffect{{MinUnits: 2, MaxUnits: 3}}},
+				},
+			},
+		},
+	}
+	doer := &pathDoer{responses: map[string]any{"/cdragon/tft/fr_fr.json": data}}
+	c := NewClient(doer, log.StandardLogger(), WithLocale("fr_FR"))
+	sets, err := c.GetTFTSets()
+	require.Nil(t, err)
+	assert.Equal(t, data.SetData, sets)
+	set, err := c.GetTFTSet("tftset9_2")
+	require.Nil(t, err)
+	unit, err := set.Unit("TFT9_Ahri")
+	require.Nil(t, err)
+	assert.Equal(t, 2, unit.Cost)
+	trait, err := set.Trait("Set9_Sorcerer")
+	require.Nil(t, err)
+	assert.Equal(t, 3, trait.Effects[0].MaxUnits)
+	_, err = set.Unit("TFT9_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = set.Trait("Set9_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetTFTSet("TFTSet10")
+	assert.Equal(t, api.ErrNotFound, err)
+	items, err := c.GetTFTItems()
+	require.Nil(t, err)
+	assert.Equal(t, data.Items, items)
+	item, err := c.GetTFTItem("TFT_Item_BFSword")
+	require.Nil(t, err)
+	assert.Equal(t, "B.F. Sword", item.Name)
+	_, err = c.GetTFTItem("TFT_Item_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Equal(t, []string{DefaultBaseURL + "/latest/cdragon/tft/fr_fr.json"}, doer.requested)
+
+	c = NewClient(mock.NewStatusMockDoer(http.StatusNotFound), log.StandardLogger())
+	_, err = c.GetTFTSets()
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetTFTSet("TFTSet9_2")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetTFTItems()
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetTFTItem("TFT_Item_BFSword")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetArenaAugments(t *testing.T) {
+	t.Parallel()
+	doer := &pathDoer{
+		responses: map[string]any{
+			"/cdragon/arena/en_us.json": arenaResponse{Augments: []ArenaAugment{{ID: 1, Name: "Tap Dancer"}}},
+		},
+	}
+	c := NewClient(doer, log.StandardLogger())
+	augment, err := c.GetArenaAugment(1)
+	require.Nil(t, err)
+	assert.Equal(t, "Tap Dancer", augment.Name)
+	_, err = c.GetArenaAugment(2)
+	assert.Equal(t, api.ErrNotFound, err)
+	c.ClearCaches()
+	_, err = c.GetArenaAugments()
+	require.Nil(t, err)
+	assert.Len(t, doer.requested, 2)
+
+	c = NewClient(mock.NewStatusMockDoer(http.StatusForbidden), log.StandardLogger())
+	_, err = c.GetArenaAugment(1)
+	assert.Equal(t, api.ErrForbidden, err)
+}
+
+func TestClient_AssetURL(t *testing.T) {
+	t.Parallel()
+	c := NewClient(http.DefaultClient, log.StandardLogger(), WithVersion("14.1"))
+	tests := []struct {
+		path string
+		want string
+	}{
+		{
+			path: "/lol-game-data/assets/v1/perk-images/Styles/Precision/PressTheAttack/PressTheAttack.png",
+			want: DefaultBaseURL +
+				"/14.1/plugins/rcp-be-lol-game-data/global/default/v1/perk-images/styles/precision/" +
+				"presstheattack/presstheattack.png",
+		},
+		{
+			path: "ASSETS/Maps/Particles/TFT/Item_Icons/Standard/BF_Sword.tex",
+			want: DefaultBaseURL + "/14.1/game/assets/maps/particles/tft/item_icons/standard/bf_sword.png",
+		},
+		{
+			path: "assets/ux/cherry/augments/icons/tapdancer_large.png",
+			want: DefaultBaseURL + "/14.1/game/assets/ux/cherry/augments/icons/tapdancer_large.png",
+		},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, c.AssetURL(tt.path))
+	}
+}
+
+func TestClient_GetTFTTrait(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewJSONMockDoer(
+		TFTData{
+			SetData: []TFTSet{
+				{Mutator: "TFTSet9", Traits: []TFTTrait{{APIName: "Set9_Sorcerer", Name: "Sorcerer"}}},
+				{Mutator: "TFTSet9_2", Traits: []TFTTrait{{APIName: "Set9_Sorcerer", Name: "Sorcerer II"}}},
+			},
+		}, http.StatusOK,
+	)
+	c := NewClient(doer, log.StandardLogger())
+	trait, err := c.GetTFTTrait("Set9_Sorcerer")
+	require.Nil(t, err)
+	assert.Equal(t, "Sorcerer", trait.Name)
+	_, err = c.GetTFTTrait("Set9_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	c = NewClient(mock.NewStatusMockDoer(http.StatusForbidden), log.StandardLogger())
+	_, err = c.GetTFTTrait("Set9_Sorcerer")
+	assert.Equal(t, api.ErrForbidden, err)
+}
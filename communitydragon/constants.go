@@ -0,0 +1,26 @@
+package communitydragon
+
+// DefaultBaseURL is the base URL of the raw Community Dragon CDN
+const DefaultBaseURL = "https://raw.communitydragon.org"
+
+// Special versions of the Community Dragon CDN. Any game version of the form major.minor, e.g. 14.1, can be used
+// as well.
+const (
+	VersionLatest = "latest"
+	VersionPBE    = "pbe"
+)
+
+// LocaleDefault is the locale used if none is given. Community Dragon serves en_us as "default" for the client data.
+const LocaleDefault = "en_us"
+
+const (
+	gameDataPath          = "/game"
+	clientDataPathFormat  = "/plugins/rcp-be-lol-game-data/global/%s"
+	championBinPathFormat = gameDataPath + "/data/characters/%[1]s/%[1]s.bin.json"
+	perksPath             = "/v1/perks.json"
+	perkStylesPath        = "/v1/perkstyles.json"
+	tftPathFormat         = "/cdragon/tft/%s.json"
+	arenaPathFormat       = "/cdragon/arena/%s.json"
+	clientAssetPrefix     = "/lol-game-data/assets"
+	binTypeSpellObject    = "SpellObject"
+)
@@ -0,0 +1,287 @@
+package communitydragon
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// ChampionBin contains the raw game data of a champion. Each entry is a bin object keyed by its path, e.g.
+// "Characters/Aatrox/Spells/AatroxQAbility/AatroxQ". The type of an object is stored in its "__type" field.
+type ChampionBin map[string]json.RawMessage
+
+// Spells returns all spell objects of the champion, including passives and spells used internally by abilities
+func (b ChampionBin) Spells() (map[string]SpellObject, error) {
+	res := map[string]SpellObject{}
+	for path, raw := range b {
+		var header struct {
+			Type string `json:"__type"`
+		}
+		if err := json.Unmarshal(raw, &header); err != nil || header.Type != binTypeSpellObject {
+			continue
+		}
+		var spell SpellObject
+		if err := json.Unmarshal(raw, &spell); err != nil {
+			return nil, err
+		}
+		res[path] = spell
+	}
+	return res, nil
+}
+
+// Spell returns the spell object with the given script name, e.g. "AatroxQ". The name is matched ignoring case.
+func (b ChampionBin) Spell(scriptName string) (SpellObject, error) {
+	spells, err := b.Spells()
+	if err != nil {
+		return SpellObject{}, err
+	}
+	for _, spell := range spells {
+		if strings.EqualFold(spell.ScriptName, scriptName) {
+			return spell, nil
+		}
+	}
+	return SpellObject{}, api.ErrNotFound
+}
+
+// SpellObject is a spell of a champion as stored in the champion's bin data
+type SpellObject struct {
+	ScriptName string    `json:"mScriptName"`
+	Spell      SpellData `json:"mSpell"`
+}
+
+// SpellData contains the detailed values of a spell. Slices hold one value per rank, starting at rank 0 which is
+// unused for most spells.
+type SpellData struct {
+	DataValues    []SpellDataValue    `json:"mDataValues"`
+	EffectAmounts []SpellEffectAmount `json:"mEffectAmount"`
+	CooldownTime  []float64           `json:"cooldownTime"`
+	Mana          []float64           `json:"mana"`
+	CastRange     []float64           `json:"castRange"`
+	CastTime      float64             `json:"mCastTime"`
+	MissileSpeed  float64             `json:"missileSpeed"`
+}
+
+// DataValue returns the values of the data value with the given name ignoring case
+func (s *SpellData) DataValue(name string) ([]float64, error) {
+	for _, value := range s.DataValues {
+		if strings.EqualFold(value.Name, name) {
+			return value.Values, nil
+		}
+	}
+	return nil, api.ErrNotFound
+}
+
+// SpellDataValue is a named value of a spell, e.g. the base damage per rank
+type SpellDataValue struct {
+	Name   string    `json:"mName"`
+	Values []float64 `json:"mValues"`
+}
+
+// SpellEffectAmount contains the legacy effect values of a spell per rank
+type SpellEffectAmount struct {
+	Values []float64 `json:"value"`
+}
+
+// Perk is a rune with its modern descriptions as used by the League client
+type Perk struct {
+	ID                       int      `json:"id"`
+	Name                     string   `json:"name"`
+	MajorChangePatchVersion  string   `json:"majorChangePatchVersion"`
+	Tooltip                  string   `json:"tooltip"`
+	ShortDesc                string   `json:"shortDesc"`
+	LongDesc                 string   `json:"longDesc"`
+	RecommendationDescriptor string   `json:"recommendationDescriptor"`
+	IconPath                 string   `json:"iconPath"`
+	EndOfGameStatDescs       []string `json:"endOfGameStatDescs"`
+}
+
+// PerkStyle is a rune path with the IDs of the perks in each of its slots
+type PerkStyle struct {
+	ID                         int        `json:"id"`
+	Name                       string     `json:"name"`
+	Tooltip                    string     `json:"tooltip"`
+	IconPath                   string     `json:"iconPath"`
+	IsAdvanced                 bool       `json:"isAdvanced"`
+	AllowedSubStyles           []int      `json:"allowedSubStyles"`
+	SubStyleBonus              []SubStyle `json:"subStyleBonus"`
+	Slots                      []PerkSlot `json:"slots"`
+	DefaultPageName            string     `json:"defaultPageName"`
+	DefaultSubStyle            int        `json:"defaultSubStyle"`
+	DefaultPerks               []int      `json:"defaultPerks"`
+	DefaultStatModsPerSubStyle []SubStyle `json:"defaultStatModsPerSubStyle"`
+}
+
+// SubStyle is a bonus perk granted when combining a perk style with another one as secondary style
+type SubStyle struct {
+	StyleID int `json:"styleId"`
+	PerkID  int `json:"perkId"`
+}
+
+// PerkSlot is a row of perks in a perk style
+type PerkSlot struct {
+	Type      string `json:"type"`
+	SlotLabel string `json:"slotLabel"`
+	Perks     []int  `json:"perks"`
+}
+
+type perkStylesResponse struct {
+	SchemaVersion int         `json:"schemaVersion"`
+	Styles        []PerkStyle `json:"styles"`
+}
+
+// TFTData contains all Teamfight Tactics data of a version, including all sets that are part of the game files
+type TFTData struct {
+	Items   []TFTItem `json:"items"`
+	SetData []TFTSet  `json:"setData"`
+}
+
+// Set returns the set with the given mutator, e.g. "TFTSet9_2". The mutator equals the tft_set_core_name of a
+// match.
+func (d *TFTData) Set(mutator string) (TFTSet, error) {
+	for _, set := range d.SetData {
+		if strings.EqualFold(set.Mutator, mutator) {
+			return set, nil
+		}
+	}
+	return TFTSet{}, api.ErrNotFound
+}
+
+// Item returns the item with the given API name, e.g. "TFT_Item_BFSword"
+func (d *TFTData) Item(apiName string) (TFTItem, error) {
+	for _, item := range d.Items {
+		if item.APIName == apiName {
+			return item, nil
+		}
+	}
+	return TFTItem{}, api.ErrNotFound
+}
+
+// Trait returns the trait with the given API name, e.g. "Set9_Sorcerer", from the first set containing it
+func (d *TFTData) Trait(apiName string) (TFTTrait, error) {
+	for i := range d.SetData {
+		if trait, err := d.SetData[i].Trait(apiName); err == nil {
+			return trait, nil
+		}
+	}
+	return TFTTrait{}, api.ErrNotFound
+}
+
+// TFTSet is a set or game mode of Teamfight Tactics with its units and traits
+type TFTSet struct {
+	Name      string     `json:"name"`
+	Mutator   string     `json:"mutator"`
+	Number    int        `json:"number"`
+	Champions []TFTUnit  `json:"champions"`
+	Traits    []TFTTrait `json:"traits"`
+}
+
+// Unit returns the unit with the given API name, e.g. "TFT9_Ahri"
+func (s *TFTSet) Unit(apiName string) (TFTUnit, error) {
+	for _, unit := range s.Champions {
+		if unit.APIName == apiName {
+			return unit, nil
+		}
+	}
+	return TFTUnit{}, api.ErrNotFound
+}
+
+// Trait returns the trait with the given API name, e.g. "Set9_Sorcerer"
+func (s *TFTSet) Trait(apiName string) (TFTTrait, error) {
+	for _, trait := range s.Traits {
+		if trait.APIName == apiName {
+			return trait, nil
+		}
+	}
+	return TFTTrait{}, api.ErrNotFound
+}
+
+// TFTUnit is a unit which can be bought in a set of Teamfight Tactics
+type TFTUnit struct {
+	APIName       string       `json:"apiName"`
+	CharacterName string       `json:"characterName"`
+	Name          string       `json:"name"`
+	Cost          int          `json:"cost"`
+	Icon          string       `json:"icon"`
+	SquareIcon    string       `json:"squareIcon"`
+	TileIcon      string       `json:"tileIcon"`
+	Traits        []string     `json:"traits"`
+	Stats         TFTUnitStats `json:"stats"`
+	Ability       TFTAbility   `json:"ability"`
+}
+
+// TFTUnitStats contains the base stats of a unit at one star
+type TFTUnitStats struct {
+	Armor          float64 `json:"armor"`
+	AttackSpeed    float64 `json:"attackSpeed"`
+	CritChance     float64 `json:"critChance"`
+	CritMultiplier float64 `json:"critMultiplier"`
+	Damage         float64 `json:"damage"`
+	HP             float64 `json:"hp"`
+	InitialMana    float64 `json:"initialMana"`
+	MagicResist    float64 `json:"magicResist"`
+	Mana           float64 `json:"mana"`
+	Range          float64 `json:"range"`
+}
+
+// TFTAbility is the ability of a unit
+type TFTAbility struct {
+	Name      string            `json:"name"`
+	Desc      string            `json:"desc"`
+	Icon      string            `json:"icon"`
+	Variables []TFTAbilityValue `json:"variables"`
+}
+
+// TFTAbilityValue is a named value of an ability, holding one value per star level
+type TFTAbilityValue struct {
+	Name  string    `json:"name"`
+	Value []float64 `json:"value"`
+}
+
+// TFTTrait is a trait of a set of Teamfight Tactics
+type TFTTrait struct {
+	APIName string           `json:"apiName"`
+	Name    string           `json:"name"`
+	Desc    string           `json:"desc"`
+	Icon    string           `json:"icon"`
+	Effects []TFTTraitEffect `json:"effects"`
+}
+
+// TFTTraitEffect is a breakpoint of a trait which is active for the given number of units
+type TFTTraitEffect struct {
+	MinUnits  int                `json:"minUnits"`
+	MaxUnits  int                `json:"maxUnits"`
+	Style     int                `json:"style"`
+	Variables map[string]float64 `json:"variables"`
+}
+
+// TFTItem is an item, augment or other equipment of Teamfight Tactics
+type TFTItem struct {
+	ID                 int                `json:"id"`
+	APIName            string             `json:"apiName"`
+	Name               string             `json:"name"`
+	Desc               string             `json:"desc"`
+	Icon               string             `json:"icon"`
+	Composition        []string           `json:"composition"`
+	AssociatedTraits   []string           `json:"associatedTraits"`
+	IncompatibleTraits []string           `json:"incompatibleTraits"`
+	Effects            map[string]float64 `json:"effects"`
+	Unique             bool               `json:"unique"`
+}
+
+// ArenaAugment is an augment of the Arena game mode
+type ArenaAugment struct {
+	ID         int                `json:"id"`
+	APIName    string             `json:"apiName"`
+	Name       string             `json:"name"`
+	Desc       string             `json:"desc"`
+	Tooltip    string             `json:"tooltip"`
+	IconLarge  string             `json:"iconLarge"`
+	IconSmall  string             `json:"iconSmall"`
+	Rarity     int                `json:"rarity"`
+	DataValues map[string]float64 `json:"dataValues"`
+}
+
+type arenaResponse struct {
+	Augments []ArenaAugment `json:"augments"`
+}
@@ -0,0 +1,188 @@
+package datadragon
+
+import (
+	"errors"
+)
+
+const (
+	// MinLevel is the level a champion starts at
+	MinLevel = 1
+	// MaxLevel is the highest level a champion can reach
+	MaxLevel = 18
+	// baseAttackSpeed is the attack speed all champions had before the attack speed offset was applied
+	baseAttackSpeed = 0.625
+)
+
+var (
+	// ErrInvalidLevel is returned if a level outside MinLevel and MaxLevel is requested
+	ErrInvalidLevel = errors.New("level must be between 1 and 18")
+	// ErrInvalidRank is returned if a rank outside 1 and the maximum rank of a spell is requested
+	ErrInvalidRank = errors.New("rank must be between 1 and the maximum rank of the spell")
+)
+
+// ChampionStats contains the stats of a champion at a level
+type ChampionStats struct {
+	Level                   int
+	HealthPoints            float64
+	HealthPointRegeneration float64
+	ManaPoints              float64
+	ManaPointRegeneration   float64
+	Armor                   float64
+	SpellBlock              float64
+	AttackDamage            float64
+	// AttackSpeed is the number of attacks per second
+	AttackSpeed          float64
+	AttackRange          float64
+	MovementSpeed        float64
+	CriticalStrikeChance float64
+}
+
+// growth returns the factor of the per level value gained until the given level. Stats do not grow linearly but
+// faster on higher levels.
+func growth(level int) float64 {
+	n := float64(level - 1)
+	return n * (0.7025 + 0.0175*n)
+}
+
+// BaseAttackSpeed returns the attack speed at level 1
+func (s *ChampionDataStats) BaseAttackSpeed() float64 {
+	if s.AttackSpeed != 0 {
+		return s.AttackSpeed
+	}
+	return baseAttackSpeed / (1 + s.AttackSpeedOffset)
+}
+
+// AtLevel returns the stats of the champion at the given level without any items, runes or buffs
+func (s *ChampionDataStats) AtLevel(level int) (ChampionStats, error) {
+	if level < MinLevel || level > MaxLevel {
+		return ChampionStats{}, ErrInvalidLevel
+	}
+	g := growth(level)
+	return ChampionStats{
+		Level:                   level,
+		HealthPoints:            s.HealthPoints + s.HealthPointsPerLevel*g,
+		HealthPointRegeneration: s.HealthPointRegeneration + s.HealthPointRegenerationPerLevel*g,
+		ManaPoints:              s.ManaPoints + s.ManaPointsPerLevel*g,
+		ManaPointRegeneration:   s.ManaPointRegeneration + s.ManaPointRegenerationPerLevel*g,
+		Armor:                   s.Armor + s.ArmorPerLevel*g,
+		SpellBlock:              s.SpellBlock + s.SpellBlockPerLevel*g,
+		AttackDamage:            s.AttackDamage + s.AttackDamagePerLevel*g,
+		AttackSpeed:             s.BaseAttackSpeed() * (1 + s.AttackSpeedPerLevel*g/100),
+		AttackRange:             s.AttackRange,
+		MovementSpeed:           s.MovementSpeed,
+		CriticalStrikeChance:    s.CriticalStrikeChance + s.CriticalStrikeChancePerLevel*g,
+	}, nil
+}
+
+// AllLevels returns the stats of the champion at every level from MinLevel to MaxLevel
+func (s *ChampionDataStats) AllLevels() []ChampionStats {
+	res := make([]ChampionStats, 0, MaxLevel)
+	for level := MinLevel; level <= MaxLevel; level++ {
+		stats, _ := s.AtLevel(level)
+		res = append(res, stats)
+	}
+	return res
+}
+
+// Sub returns the difference of the stats to the given stats
+func (s *ChampionStats) Sub(other *ChampionStats) ChampionStats {
+	return ChampionStats{
+		Level:                   s.Level,
+		HealthPoints:            s.HealthPoints - other.HealthPoints,
+		HealthPointRegeneration: s.HealthPointRegeneration - other.HealthPointRegeneration,
+		ManaPoints:              s.ManaPoints - other.ManaPoints,
+		ManaPointRegeneration:   s.ManaPointRegeneration - other.ManaPointRegeneration,
+		Armor:                   s.Armor - other.Armor,
+		SpellBlock:              s.SpellBlock - other.SpellBlock,
+		AttackDamage:            s.AttackDamage - other.AttackDamage,
+		AttackSpeed:             s.AttackSpeed - other.AttackSpeed,
+		AttackRange:             s.AttackRange - other.AttackRange,
+		MovementSpeed:           s.MovementSpeed - other.MovementSpeed,
+		CriticalStrikeChance:    s.CriticalStrikeChance - other.CriticalStrikeChance,
+	}
+}
+
+// StatComparison contains the stats of two champions at the same level
+type StatComparison struct {
+	Level  int
+	First  ChampionStats
+	Second ChampionStats
+	// Difference contains the stats of the first champion minus the stats of the second
+	Difference ChampionStats
+}
+
+// CompareChampions compares the stats of two champions at the given level
+func CompareChampions(first, second *ChampionData, level int) (StatComparison, error) {
+	firstStats, err := first.Stats.AtLevel(level)
+	if err != nil {
+		return StatComparison{}, err
+	}
+	secondStats, err := second.Stats.AtLevel(level)
+	if err != nil {
+		return StatComparison{}, err
+	}
+	return StatComparison{
+		Level:      level,
+		First:      firstStats,
+		Second:     secondStats,
+		Difference: firstStats.Sub(&secondStats),
+	}, nil
+}
+
+// CompareChampionsAllLevels compares the stats of two champions at every level from MinLevel to MaxLevel
+func CompareChampionsAllLevels(first, second *ChampionData) []StatComparison {
+	res := make([]StatComparison, 0, MaxLevel)
+	for level := MinLevel; level <= MaxLevel; level++ {
+		comparison, _ := CompareChampions(first, second, level)
+		res = append(res, comparison)
+	}
+	return res
+}
+
+// CooldownWithHaste returns the cooldown reduced by the given amount of ability haste
+func CooldownWithHaste(cooldown, haste float64) float64 {
+	return cooldown * 100 / (100 + haste)
+}
+
+// CooldownAtRank returns the cooldown of the spell at the given rank reduced by the given amount of ability haste
+func (s *SpellData) CooldownAtRank(rank int, haste float64) (float64, error) {
+	cooldown, err := s.atRank(s.Cooldown, rank)
+	if err != nil {
+		return 0, err
+	}
+	return CooldownWithHaste(cooldown, haste), nil
+}
+
+// Cooldowns returns the cooldowns of the spell at all ranks reduced by the given amount of ability haste
+func (s *SpellData) Cooldowns(haste float64) []float64 {
+	res := make([]float64, len(s.Cooldown))
+	for i, cooldown := range s.Cooldown {
+		res[i] = CooldownWithHaste(cooldown, haste)
+	}
+	return res
+}
+
+// CostAtRank returns the cost of the spell at the given rank
+func (s *SpellData) CostAtRank(rank int) (float64, error) {
+	return s.atRank(s.Cost, rank)
+}
+
+// RangeAtRank returns the range of the spell at the given rank
+func (s *SpellData) RangeAtRank(rank int) (float64, error) {
+	return s.atRank(s.Range, rank)
+}
+
+// atRank returns the value for the given rank. Values which do not change with the rank are only listed once.
+func (s *SpellData) atRank(values []float64, rank int) (float64, error) {
+	maxRank := s.MaxRank
+	if maxRank == 0 {
+		maxRank = len(values)
+	}
+	if rank < 1 || rank > maxRank || len(values) == 0 {
+		return 0, ErrInvalidRank
+	}
+	if rank > len(values) {
+		return values[len(values)-1], nil
+	}
+	return values[rank-1], nil
+}
@@ -0,0 +1,164 @@
+package datadragon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testAsheStats = ChampionDataStats{
+	HealthPoints:         610,
+	HealthPointsPerLevel: 101,
+	ManaPoints:           280,
+	ManaPointsPerLevel:   35,
+	MovementSpeed:        325,
+	Armor:                26,
+	ArmorPerLevel:        4.6,
+	SpellBlock:           30,
+	SpellBlockPerLevel:   1.3,
+	AttackRange:          600,
+	AttackDamage:         59,
+	AttackDamagePerLevel: 2.95,
+	AttackSpeed:          0.658,
+	AttackSpeedPerLevel:  3.33,
+}
+
+func TestChampionDataStats_AtLevel(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		stats   ChampionDataStats
+		level   int
+		want    ChampionStats
+		wantErr error
+	}{
+		{
+			name:  "level 1",
+			stats: testAsheStats,
+			level: 1,
+			want: ChampionStats{
+				Level: 1, HealthPoints: 610, ManaPoints: 280, Armor: 26, SpellBlock: 30, AttackDamage: 59,
+				AttackSpeed: 0.658, AttackRange: 600, MovementSpeed: 325,
+			},
+		},
+		{
+			name:  "level 2",
+			stats: testAsheStats,
+			level: 2,
+			want: ChampionStats{
+				Level: 2, HealthPoints: 682.72, ManaPoints: 305.2, Armor: 29.312, SpellBlock: 30.936,
+				AttackDamage: 61.124, AttackSpeed: 0.658 * (1 + 3.33*0.72/100), AttackRange: 600, MovementSpeed: 325,
+			},
+		},
+		{
+			name:  "level 18",
+			stats: testAsheStats,
+			level: 18,
+			want: ChampionStats{
+				Level: 18, HealthPoints: 2327, ManaPoints: 875, Armor: 104.2, SpellBlock: 52.1,
+				AttackDamage: 109.15, AttackSpeed: 0.658 * 1.5661, AttackRange: 600, MovementSpeed: 325,
+			},
+		},
+		{
+			name:  "attack speed from offset",
+			stats: ChampionDataStats{AttackSpeedOffset: -0.04},
+			level: 1,
+			want:  ChampionStats{Level: 1, AttackSpeed: 0.625 / 0.96},
+		},
+		{
+			name:    "level too low",
+			stats:   testAsheStats,
+			level:   0,
+			wantErr: ErrInvalidLevel,
+		},
+		{
+			name:    "level too high",
+			stats:   testAsheStats,
+			level:   19,
+			wantErr: ErrInvalidLevel,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := tt.stats.AtLevel(tt.level)
+				require.Equal(t, tt.wantErr, err)
+				assertStatsEqual(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func assertStatsEqual(t *testing.T, want, got ChampionStats) {
+	assert.Equal(t, want.Level, got.Level)
+	assert.InDelta(t, want.HealthPoints, got.HealthPoints, 1e-9)
+	assert.InDelta(t, want.ManaPoints, got.ManaPoints, 1e-9)
+	assert.InDelta(t, want.Armor, got.Armor, 1e-9)
+	assert.InDelta(t, want.SpellBlock, got.SpellBlock, 1e-9)
+	assert.InDelta(t, want.AttackDamage, got.AttackDamage, 1e-9)
+	assert.InDelta(t, want.AttackSpeed, got.AttackSpeed, 1e-9)
+	assert.InDelta(t, want.AttackRange, got.AttackRange, 1e-9)
+	assert.InDelta(t, want.MovementSpeed, got.MovementSpeed, 1e-9)
+}
+
+func TestChampionDataStats_AllLevels(t *testing.T) {
+	t.Parallel()
+	got := testAsheStats.AllLevels()
+	require.Len(t, got, MaxLevel)
+	for i, stats := range got {
+		assert.Equal(t, i+1, stats.Level)
+		if i > 0 {
+			assert.Greater(t, stats.HealthPoints, got[i-1].HealthPoints)
+		}
+	}
+	assert.InDelta(t, 2327, got[MaxLevel-1].HealthPoints, 1e-9)
+}
+
+func TestCompareChampions(t *testing.T) {
+	t.Parallel()
+	ashe := ChampionData{ID: "Ashe", Stats: testAsheStats}
+	tanky := ChampionData{ID: "Tanky", Stats: testAsheStats}
+	tanky.Stats.HealthPoints = 700
+	tanky.Stats.ArmorPerLevel = 5.6
+	got, err := CompareChampions(&tanky, &ashe, 18)
+	require.Nil(t, err)
+	assert.Equal(t, 18, got.Level)
+	assert.InDelta(t, 90, got.Difference.HealthPoints, 1e-9)
+	assert.InDelta(t, 17, got.Difference.Armor, 1e-9)
+	assert.InDelta(t, 0, got.Difference.AttackSpeed, 1e-9)
+	_, err = CompareChampions(&tanky, &ashe, 0)
+	assert.Equal(t, ErrInvalidLevel, err)
+
+	all := CompareChampionsAllLevels(&tanky, &ashe)
+	require.Len(t, all, MaxLevel)
+	assert.InDelta(t, 0, all[0].Difference.Armor, 1e-9)
+	assert.Equal(t, got, all[MaxLevel-1])
+}
+
+func TestSpellData(t *testing.T) {
+	t.Parallel()
+	spell := SpellData{
+		MaxRank:  5,
+		Cooldown: []float64{18, 16, 14, 12, 10},
+		Cost:     []float64{50},
+		Range:    []float64{1200, 1200, 1200, 1200, 1200},
+	}
+	cooldown, err := spell.CooldownAtRank(1, 20)
+	require.Nil(t, err)
+	assert.InDelta(t, 15, cooldown, 1e-9)
+	assert.InDeltaSlice(t, []float64{9, 8, 7, 6, 5}, spell.Cooldowns(100), 1e-9)
+	cost, err := spell.CostAtRank(5)
+	require.Nil(t, err)
+	assert.InDelta(t, 50, cost, 1e-9)
+	spellRange, err := spell.RangeAtRank(3)
+	require.Nil(t, err)
+	assert.InDelta(t, 1200, spellRange, 1e-9)
+	_, err = spell.CooldownAtRank(6, 0)
+	assert.Equal(t, ErrInvalidRank, err)
+	_, err = spell.CooldownAtRank(0, 0)
+	assert.Equal(t, ErrInvalidRank, err)
+	_, err = (&SpellData{}).CostAtRank(1)
+	assert.Equal(t, ErrInvalidRank, err)
+	assert.InDelta(t, 10, CooldownWithHaste(10, 0), 1e-9)
+}
@@ -1,9 +1,12 @@
 package datadragon
 
+// DefaultBaseURL is the base URL of the official Data Dragon CDN
+const DefaultBaseURL = "https://ddragon.leagueoflegends.com"
+
 type dataDragonURL string
 
 const (
-	dataDragonBaseURL        dataDragonURL = "ddragon.leagueoflegends.com"
+	dataDragonBaseURL        dataDragonURL = ""
 	dataDragonDataURLFormat                = dataDragonBaseURL + "/cdn/%s/data/%s"
 	dataDragonImageURLFormat               = dataDragonBaseURL + "/cdn/%s/img"
 )
@@ -75,3 +78,17 @@ var (
 		LanguageCodeTaiwan,
 	}
 )
+
+// StatShards contains all stat shards by their perk ID. Data Dragon does not provide stat shards, so their names
+// and descriptions are English only and do not depend on the language of a client.
+var StatShards = map[int]StatShard{
+	5001: {ID: 5001, Name: "Health Scaling", Description: "+10-180 Health (based on level)"},
+	5002: {ID: 5002, Name: "Armor", Description: "+6 Armor"},
+	5003: {ID: 5003, Name: "Magic Resist", Description: "+8 Magic Resist"},
+	5005: {ID: 5005, Name: "Attack Speed", Description: "+10% Attack Speed"},
+	5007: {ID: 5007, Name: "Ability Haste", Description: "+8 Ability Haste"},
+	5008: {ID: 5008, Name: "Adaptive Force", Description: "+9 Adaptive Force"},
+	5010: {ID: 5010, Name: "Move Speed", Description: "+2% Move Speed"},
+	5011: {ID: 5011, Name: "Health", Description: "+65 Health"},
+	5013: {ID: 5013, Name: "Tenacity and Slow Resist", Description: "+10% Tenacity and Slow Resist"},
+}
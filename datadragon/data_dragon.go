@@ -5,6 +5,7 @@ package datadragon
 import (
 	"encoding/json"
 	"fmt"
+	"image"
 	"net/http"
 	"strconv"
 	"strings"
@@ -23,35 +24,20 @@ const (
 	fallbackLanguage            = LanguageCodeUnitedStates
 )
 
-var (
-	regionToRealmRegion = map[api.Region]string{
-		api.RegionBrasil:            "br",
-		api.RegionEuropeWest:        "euw",
-		api.RegionEuropeNorthEast:   "eun",
-		api.RegionJapan:             "jp",
-		api.RegionKorea:             "kr",
-		api.RegionLatinAmericaNorth: "lan",
-		api.RegionLatinAmericaSouth: "las",
-		api.RegionMiddleEast:        "me",
-		api.RegionNorthAmerica:      "na",
-		api.RegionOceania:           "oce",
-		api.RegionPBE:               "pbe",
-		api.RegionRussia:            "ru",
-		api.RegionSouthEastAsia:     "sea",
-		api.RegionTurkey:            "tr",
-		api.RegionTaiwan:            "tw",
-		api.RegionVietnam:           "vn",
-	}
-)
-
 // Client provides access to all data provided by the Data Dragon service
 type Client struct {
 	logger             log.FieldLogger
 	Version            string
 	Language           languageCode
 	client             internal.Doer
+	baseURL            string
+	registry           *registry
+	realm              string
+	initErr            error
 	championsMu        sync.RWMutex
 	championsById      map[string]ChampionDataExtended
+	championIDsByKey   map[string]string
+	championIDsByName  map[string]string
 	getChampionsToggle uint32
 	profileIconsMu     sync.RWMutex
 	profileIcons       []ProfileIcon
@@ -61,42 +47,92 @@ type Client struct {
 	masteries          []Mastery
 	runesMu            sync.RWMutex
 	runes              []Item
+	runesReforgedMu    sync.RWMutex
+	runesReforged      []RunePath
 	summonersMu        sync.RWMutex
 	summoners          []SummonerSpell
+	spritesMu          sync.RWMutex
+	sprites            map[string]image.Image
+	tftChampionsMu     sync.RWMutex
+	tftChampions       map[string]TFTChampion
+	tftTraitsMu        sync.RWMutex
+	tftTraits          map[string]TFTTrait
+	tftItemsMu         sync.RWMutex
+	tftItems           map[string]TFTItem
+	tftAugmentsMu      sync.RWMutex
+	tftAugments        map[string]TFTAugment
+}
+
+// Option is used to alter the attributes of a Data Dragon client
+type Option func(*Client)
+
+// WithBaseURL sets the base URL used for all requests instead of DefaultBaseURL, e.g. to use a caching proxy
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
 }
 
 // NewClient returns a new client for the Data Dragon service.
-func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger) *Client {
+func NewClient(client internal.Doer, region api.Region, logger log.FieldLogger, options ...Option) *Client {
 	c := &Client{
-		client:        client,
-		logger:        logger.WithField("client", "data dragon"),
-		championsById: map[string]ChampionDataExtended{},
-	}
-	if err := c.init(regionToRealmRegion[region]); err != nil {
+		client:            client,
+		logger:            logger.WithField("client", "data dragon"),
+		baseURL:           DefaultBaseURL,
+		registry:          newRegistry(),
+		championsById:     map[string]ChampionDataExtended{},
+		championIDsByKey:  map[string]string{},
+		championIDsByName: map[string]string{},
+	}
+	for _, opt := range options {
+		opt(c)
+	}
+	if info, err := api.LookupRegion(region); err == nil {
+		c.realm = info.Realm
+	}
+	if err := c.init(); err != nil {
+		c.logger.WithError(err).Warnf("could not load realm, falling back to version %s", fallbackVersion)
+		c.initErr = err
 		c.Version = fallbackVersion
 		c.Language = fallbackLanguage
 	}
+	c.registry.add(c)
 	return c
 }
 
-func (c *Client) init(region string) error {
+// InitError returns the error which occurred while loading the current version and language of the region
+// during construction of the client. If it is not nil, the client uses a fallback version and language.
+func (c *Client) InitError() error {
+	return c.initErr
+}
+
+func (c *Client) init() error {
+	version, language, err := c.getRealm()
+	if err != nil {
+		return err
+	}
+	c.Version = version
+	c.Language = language
+	return nil
+}
+
+// getRealm returns the current version and default language of the region of the client
+func (c *Client) getRealm() (string, languageCode, error) {
 	var res struct {
 		Version  string `json:"v"`
 		Language string `json:"l"`
 	}
-	response, err := c.doRequest(dataDragonBaseURL, fmt.Sprintf("/realms/%s.json", region))
+	response, err := c.doRequest(dataDragonBaseURL, fmt.Sprintf("/realms/%s.json", c.realm))
 	if err != nil {
-		return err
+		return "", "", err
 	}
 	if response.Body == nil {
-		return fmt.Errorf("no response body")
+		return "", "", fmt.Errorf("no response body")
 	}
 	if err := json.NewDecoder(response.Body).Decode(&res); err != nil {
-		return err
+		return "", "", err
 	}
-	c.Version = res.Version
-	c.Language = languageCode(res.Language)
-	return nil
+	return res.Version, languageCode(res.Language), nil
 }
 
 // GetChampions returns all existing champions
@@ -112,6 +148,8 @@ func (c *Client) GetChampions() ([]ChampionData, error) {
 		for _, champion := range champions {
 			data := ChampionDataExtended{ChampionData: champion}
 			c.championsById[champion.ID] = data
+			c.championIDsByKey[champion.Key] = champion.ID
+			c.championIDsByName[normalizeName(champion.Name)] = champion.ID
 		}
 	}
 	res := make([]ChampionData, 0, len(c.championsById))
@@ -141,18 +179,38 @@ func (c *Client) GetChampionByID(id string) (ChampionDataExtended, error) {
 	return champion, nil
 }
 
-// GetChampion returns information about the champion with the given name
+// GetChampion returns information about the champion with the given name. The name is matched ignoring case,
+// diacritics, spaces and punctuation, e.g. "kaisa" matches "Kai'Sa".
 func (c *Client) GetChampion(name string) (ChampionDataExtended, error) {
-	champions, err := c.GetChampions()
+	id, err := c.championIDFromIndex(&c.championIDsByName, normalizeName(name))
 	if err != nil {
 		return ChampionDataExtended{}, err
 	}
-	for _, champion := range champions {
-		if champion.Name == name {
-			return c.GetChampionByID(champion.ID)
-		}
+	return c.GetChampionByID(id)
+}
+
+// GetChampionByKey returns information about the champion with the given numeric key. This is the champion ID
+// used by the Riot API, e.g. in matches and champion masteries.
+func (c *Client) GetChampionByKey(key int) (ChampionDataExtended, error) {
+	id, err := c.championIDFromIndex(&c.championIDsByKey, strconv.Itoa(key))
+	if err != nil {
+		return ChampionDataExtended{}, err
 	}
-	return ChampionDataExtended{}, api.ErrNotFound
+	return c.GetChampionByID(id)
+}
+
+// championIDFromIndex looks up a champion ID in the given index, which is built by GetChampions
+func (c *Client) championIDFromIndex(index *map[string]string, key string) (string, error) {
+	if _, err := c.GetChampions(); err != nil {
+		return "", err
+	}
+	c.championsMu.RLock()
+	defer c.championsMu.RUnlock()
+	id, ok := (*index)[key]
+	if !ok {
+		return "", api.ErrNotFound
+	}
+	return id, nil
 }
 
 // GetProfileIcons returns all existing profile icons
@@ -260,7 +318,8 @@ func (c *Client) GetMastery(id int) (Mastery, error) {
 }
 
 // GetRunes returns all existing runes. Runes were removed in patch 7.23.1. If any version higher than that
-// is specified the last available version will be used instead.
+// is specified the last available version will be used instead. Use GetRunesReforged for the runes used since
+// then.
 func (c *Client) GetRunes() ([]Item, error) {
 	unlock, toggle := internal.RWLockToggle(&c.runesMu)
 	defer unlock()
@@ -295,6 +354,65 @@ func (c *Client) GetRune(id string) (Item, error) {
 	return Item{}, api.ErrNotFound
 }
 
+// GetRunesReforged returns all rune paths with their slots and runes
+func (c *Client) GetRunesReforged() ([]RunePath, error) {
+	unlock, toggle := internal.RWLockToggle(&c.runesReforgedMu)
+	defer unlock()
+	if len(c.runesReforged) < 1 {
+		toggle()
+		// runesReforged.json is not wrapped in the usual Data Dragon response but a plain array
+		var res []RunePath
+		if err := c.getRawInto("/runesReforged.json", &res); err != nil {
+			return nil, err
+		}
+		c.runesReforged = res
+	}
+	res := make([]RunePath, len(c.runesReforged))
+	copy(res, c.runesReforged)
+	return res, nil
+}
+
+// GetRunePath returns information about the rune path with the given id, e.g. 8000 for Precision
+func (c *Client) GetRunePath(id int) (RunePath, error) {
+	paths, err := c.GetRunesReforged()
+	if err != nil {
+		return RunePath{}, err
+	}
+	for _, path := range paths {
+		if path.ID == id {
+			return path, nil
+		}
+	}
+	return RunePath{}, api.ErrNotFound
+}
+
+// GetRuneReforged returns information about the rune with the given id, e.g. 8005 for Press the Attack
+func (c *Client) GetRuneReforged(id int) (RuneReforged, error) {
+	paths, err := c.GetRunesReforged()
+	if err != nil {
+		return RuneReforged{}, err
+	}
+	for _, path := range paths {
+		for _, slot := range path.Slots {
+			for _, r := range slot.Runes {
+				if r.ID == id {
+					return r, nil
+				}
+			}
+		}
+	}
+	return RuneReforged{}, api.ErrNotFound
+}
+
+// GetStatShard returns the stat shard with the given perk ID. The name and description are always English.
+func GetStatShard(id int) (StatShard, error) {
+	shard, ok := StatShards[id]
+	if !ok {
+		return StatShard{}, api.ErrNotFound
+	}
+	return shard, nil
+}
+
 // GetSummonerSpells returns all existing summoner spells
 func (c *Client) GetSummonerSpells() ([]SummonerSpell, error) {
 	unlock, toggle := internal.RWLockToggle(&c.summonersMu)
@@ -333,6 +451,8 @@ func (c *Client) GetSummonerSpell(id string) (SummonerSpell, error) {
 func (c *Client) ClearCaches() {
 	c.championsMu.Lock()
 	c.championsById = map[string]ChampionDataExtended{}
+	c.championIDsByKey = map[string]string{}
+	c.championIDsByName = map[string]string{}
 	atomic.StoreUint32(&c.getChampionsToggle, 0)
 	c.championsMu.Unlock()
 	c.masteriesMu.Lock()
@@ -350,6 +470,24 @@ func (c *Client) ClearCaches() {
 	c.runesMu.Lock()
 	c.runes = []Item{}
 	c.runesMu.Unlock()
+	c.runesReforgedMu.Lock()
+	c.runesReforged = []RunePath{}
+	c.runesReforgedMu.Unlock()
+	c.spritesMu.Lock()
+	c.sprites = map[string]image.Image{}
+	c.spritesMu.Unlock()
+	c.tftChampionsMu.Lock()
+	c.tftChampions = map[string]TFTChampion{}
+	c.tftChampionsMu.Unlock()
+	c.tftTraitsMu.Lock()
+	c.tftTraits = map[string]TFTTrait{}
+	c.tftTraitsMu.Unlock()
+	c.tftItemsMu.Lock()
+	c.tftItems = map[string]TFTItem{}
+	c.tftItemsMu.Unlock()
+	c.tftAugmentsMu.Lock()
+	c.tftAugments = map[string]TFTAugment{}
+	c.tftAugmentsMu.Unlock()
 }
 
 func (c *Client) getInto(endpoint string, target any) error {
@@ -366,11 +504,32 @@ func (c *Client) getInto(endpoint string, target any) error {
 	return json.Unmarshal(data, &target)
 }
 
+func (c *Client) getRawInto(endpoint string, target any) error {
+	response, err := c.doRequest(dataDragonDataURLFormat, endpoint)
+	if err != nil {
+		return err
+	}
+	return json.NewDecoder(response.Body).Decode(target)
+}
+
 func (c *Client) doRequest(format dataDragonURL, endpoint string) (*http.Response, error) {
 	request, err := c.newRequest(format, endpoint)
 	if err != nil {
 		return nil, err
 	}
+	return c.send(request)
+}
+
+// do sends a GET request to the given absolute URL
+func (c *Client) do(url string) (*http.Response, error) {
+	request, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(request)
+}
+
+func (c *Client) send(request *http.Request) (*http.Response, error) {
 	response, err := c.client.Do(request)
 	if err != nil {
 		return nil, err
@@ -391,7 +550,7 @@ func (c *Client) doRequest(format dataDragonURL, endpoint string) (*http.Respons
 
 func (c *Client) newRequest(format dataDragonURL, endpoint string) (*http.Request, error) {
 	var version string
-	if (strings.Contains(endpoint, "rune") || strings.Contains(endpoint, "mastery")) &&
+	if (endpoint == "/rune.json" || endpoint == "/mastery.json") &&
 		versionGreaterThan(c.Version, latestRuneAndMasteryVersion) {
 		version = latestRuneAndMasteryVersion
 	} else {
@@ -406,7 +565,7 @@ func (c *Client) newRequest(format dataDragonURL, endpoint string) (*http.Reques
 	default:
 		url = string(format)
 	}
-	url = "https://" + url + endpoint
+	url = c.baseURL + url + endpoint
 	request, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
@@ -426,8 +585,8 @@ func versionGreaterThan(v1, v2 string) bool {
 		if err != nil {
 			return false
 		}
-		if int1 > int2 {
-			return true
+		if int1 != int2 {
+			return int1 > int2
 		}
 	}
 	return false
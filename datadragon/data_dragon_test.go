@@ -734,7 +734,8 @@ func TestClient_init(t *testing.T) {
 		t.Run(
 			tt.name, func(t *testing.T) {
 				c := NewClient(tt.doer, api.RegionOceania, log.StandardLogger())
-				if err := c.init(string(api.RegionOceania)); (err != nil) != tt.wantErr {
+				assert.Equal(t, tt.wantErr, c.InitError() != nil)
+				if err := c.init(); (err != nil) != tt.wantErr {
 					t.Errorf("Client.init() error = %v, wantErr %v", err, tt.wantErr)
 				}
 			},
@@ -793,6 +794,22 @@ func Test_versionGreaterThan(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "second greater with greater minor version",
+			args: args{
+				v1: "6.30.1",
+				v2: "7.1.1",
+			},
+			want: false,
+		},
+		{
+			name: "first greater",
+			args: args{
+				v1: "14.3.1",
+				v2: "7.23.1",
+			},
+			want: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(
@@ -822,3 +839,172 @@ func (e errorReadCloser) Read(p []byte) (n int, err error) {
 func (e errorReadCloser) Close() error {
 	return fmt.Errorf("error")
 }
+
+func TestWithBaseURL(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = append(requested, r.URL.String())
+			return mock.NewJSONMockDoer(dataDragonResponse{Data: map[string]Item{}}, 200).Do(r)
+		},
+	}
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger(), WithBaseURL("http://localhost:8080/dd/"))
+	c.Version = "1.1.1"
+	c.Language = LanguageCodeUnitedStates
+	_, err := c.GetItems()
+	assert.Nil(t, err)
+	assert.Equal(
+		t, []string{
+			"http://localhost:8080/dd/realms/euw.json",
+			"http://localhost:8080/dd/cdn/1.1.1/data/en_US/item.json",
+		}, requested,
+	)
+}
+
+var testRunePaths = []RunePath{
+	{
+		ID:   8000,
+		Key:  "Precision",
+		Name: "Precision",
+		Slots: []RuneSlot{
+			{Runes: []RuneReforged{{ID: 8005, Key: "PressTheAttack"}, {ID: 8021, Key: "FleetFootwork"}}},
+			{Runes: []RuneReforged{{ID: 9101, Key: "AbsorbLife"}}},
+		},
+	},
+	{
+		ID:    8100,
+		Key:   "Domination",
+		Name:  "Domination",
+		Slots: []RuneSlot{{Runes: []RuneReforged{{ID: 8112, Key: "Electrocute"}}}},
+	},
+}
+
+func TestClient_GetRunesReforged(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		want    []RunePath
+		wantErr error
+	}{
+		{
+			name: "get response",
+			doer: mock.NewJSONMockDoer(testRunePaths, 200),
+			want: testRunePaths,
+		},
+		{
+			name:    "known error",
+			doer:    mock.NewStatusMockDoer(http.StatusForbidden),
+			wantErr: api.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(tt.doer, api.RegionEuropeWest, log.StandardLogger())
+				got, err := c.GetRunesReforged()
+				assert.Equal(t, tt.wantErr, err)
+				if tt.wantErr == nil {
+					assert.Equal(t, tt.want, got)
+					got, err := c.GetRunesReforged()
+					assert.Nil(t, err)
+					assert.Equal(t, tt.want, got)
+				}
+			},
+		)
+	}
+}
+
+func TestClient_GetRunePath(t *testing.T) {
+	t.Parallel()
+	c := NewClient(mock.NewJSONMockDoer(testRunePaths, 200), api.RegionEuropeWest, log.StandardLogger())
+	got, err := c.GetRunePath(8100)
+	require.Nil(t, err)
+	assert.Equal(t, testRunePaths[1], got)
+	_, err = c.GetRunePath(1)
+	assert.Equal(t, api.ErrNotFound, err)
+	c = NewClient(mock.NewStatusMockDoer(999), api.RegionEuropeWest, log.StandardLogger())
+	_, err = c.GetRunePath(8100)
+	assert.Equal(t, api.Error{Message: api.ErrMsgUnknown, StatusCode: 999}, err)
+}
+
+func TestClient_GetRuneReforged(t *testing.T) {
+	t.Parallel()
+	c := NewClient(mock.NewJSONMockDoer(testRunePaths, 200), api.RegionEuropeWest, log.StandardLogger())
+	got, err := c.GetRuneReforged(9101)
+	require.Nil(t, err)
+	assert.Equal(t, RuneReforged{ID: 9101, Key: "AbsorbLife"}, got)
+	_, err = c.GetRuneReforged(8000)
+	assert.Equal(t, api.ErrNotFound, err)
+	c = NewClient(mock.NewStatusMockDoer(999), api.RegionEuropeWest, log.StandardLogger())
+	_, err = c.GetRuneReforged(9101)
+	assert.Equal(t, api.Error{Message: api.ErrMsgUnknown, StatusCode: 999}, err)
+}
+
+func TestGetStatShard(t *testing.T) {
+	t.Parallel()
+	got, err := GetStatShard(5008)
+	require.Nil(t, err)
+	assert.Equal(t, "Adaptive Force", got.Name)
+	_, err = GetStatShard(8005)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_newRequestRuneVersion(t *testing.T) {
+	t.Parallel()
+	c := NewClient(mock.NewStatusMockDoer(999), api.RegionEuropeWest, log.StandardLogger())
+	c.Version = "14.3.1"
+	c.Language = LanguageCodeUnitedStates
+	tests := map[string]string{
+		"/rune.json":          "https://ddragon.leagueoflegends.com/cdn/7.23.1/data/en_US/rune.json",
+		"/mastery.json":       "https://ddragon.leagueoflegends.com/cdn/7.23.1/data/en_US/mastery.json",
+		"/runesReforged.json": "https://ddragon.leagueoflegends.com/cdn/14.3.1/data/en_US/runesReforged.json",
+	}
+	for endpoint, want := range tests {
+		request, err := c.newRequest(dataDragonDataURLFormat, endpoint)
+		require.Nil(t, err)
+		assert.Equal(t, want, request.URL.String())
+	}
+}
+
+func TestClient_GetChampionByKey(t *testing.T) {
+	t.Parallel()
+	champions := map[string]ChampionData{
+		"Kaisa": {ID: "Kaisa", Key: "145", Name: "Kai'Sa"},
+		"Ashe":  {ID: "Ashe", Key: "22", Name: "Ashe"},
+	}
+	doer := mock.NewPathJSONMockDoer(
+		[]mock.PathJSONResponse{
+			{PathSuffix: "/champion.json", Object: dataDragonResponse{Data: champions}, Code: 200},
+			{
+				PathSuffix: "/champion/Kaisa.json",
+				Object: dataDragonResponse{
+					Data: map[string]ChampionDataExtended{
+						"Kaisa": {ChampionData: champions["Kaisa"], Lore: "lore"},
+					},
+				},
+				Code: 200,
+			},
+		},
+	)
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	want := ChampionDataExtended{ChampionData: champions["Kaisa"], Lore: "lore"}
+	got, err := c.GetChampionByKey(145)
+	require.Nil(t, err)
+	assert.Equal(t, want, got)
+	for _, name := range []string{"Kai'Sa", "kaisa", "KAI SA"} {
+		got, err = c.GetChampion(name)
+		require.Nil(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err = c.GetChampionByKey(1)
+	assert.Equal(t, api.ErrNotFound, err)
+	c.ClearCaches()
+	_, err = c.GetChampionByKey(145)
+	assert.Nil(t, err)
+
+	c = NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger())
+	_, err = c.GetChampionByKey(145)
+	assert.Equal(t, api.ErrForbidden, err)
+}
@@ -0,0 +1,162 @@
+package datadragon
+
+import (
+	"fmt"
+	"image"
+	// register decoders for the image formats used by Data Dragon
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+const (
+	// champion art is not versioned and located directly below /cdn/img
+	dataDragonChampionArtURLFormat = "/cdn/img/champion/%s/%s_%d.jpg"
+	dataDragonPerkImageURLFormat   = "/cdn/img/%s"
+)
+
+// Kinds of champion art available for each skin
+const (
+	ChampionArtSplash  = "splash"
+	ChampionArtLoading = "loading"
+	ChampionArtTiles   = "tiles"
+)
+
+// URL returns the URL of the image, e.g. a champion portrait or an item icon
+func (i ImageData) URL(client *Client) string {
+	return client.imageURL(i.Group + "/" + i.Full)
+}
+
+// SpriteURL returns the URL of the sprite sheet containing the image
+func (i ImageData) SpriteURL(client *Client) string {
+	return client.imageURL("sprite/" + i.Sprite)
+}
+
+// ImageURL returns the URL of the square portrait of the champion
+func (d *ChampionData) ImageURL(client *Client) string {
+	return d.Image.URL(client)
+}
+
+// SplashURL returns the URL of the splash art of this skin of the champion with the given ID, e.g. "Ashe"
+func (s SkinData) SplashURL(client *Client, championID string) string {
+	return client.championArtURL(ChampionArtSplash, championID, s.Num)
+}
+
+// LoadingURL returns the URL of the loading screen art of this skin of the champion with the given ID
+func (s SkinData) LoadingURL(client *Client, championID string) string {
+	return client.championArtURL(ChampionArtLoading, championID, s.Num)
+}
+
+// TileURL returns the URL of the tile art of this skin of the champion with the given ID
+func (s SkinData) TileURL(client *Client, championID string) string {
+	return client.championArtURL(ChampionArtTiles, championID, s.Num)
+}
+
+// ImageURL returns the URL of the icon of the spell
+func (s *SpellData) ImageURL(client *Client) string {
+	return s.Image.URL(client)
+}
+
+// ImageURL returns the URL of the icon of the passive
+func (p *PassiveData) ImageURL(client *Client) string {
+	return p.Image.URL(client)
+}
+
+// ImageURL returns the URL of the profile icon
+func (i *ProfileIcon) ImageURL(client *Client) string {
+	return i.Image.URL(client)
+}
+
+// ImageURL returns the URL of the icon of the item
+func (i *Item) ImageURL(client *Client) string {
+	return i.Image.URL(client)
+}
+
+// ImageURL returns the URL of the icon of the summoner spell
+func (s *SummonerSpell) ImageURL(client *Client) string {
+	return s.Image.URL(client)
+}
+
+// IconURL returns the URL of the icon of the rune path
+func (p RunePath) IconURL(client *Client) string {
+	return client.baseURL + fmt.Sprintf(dataDragonPerkImageURLFormat, p.Icon)
+}
+
+// IconURL returns the URL of the icon of the rune
+func (r *RuneReforged) IconURL(client *Client) string {
+	return client.baseURL + fmt.Sprintf(dataDragonPerkImageURLFormat, r.Icon)
+}
+
+// Download returns the content of the file at the given URL, e.g. one returned by the URL builders
+func (c *Client) Download(url string) ([]byte, error) {
+	response, err := c.do(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return io.ReadAll(response.Body)
+}
+
+// GetImage downloads and decodes the image
+func (c *Client) GetImage(img ImageData) (image.Image, error) {
+	return c.downloadImage(img.URL(c))
+}
+
+// GetSpriteImage returns the part of the sprite sheet containing the image. Sprite sheets are cached.
+func (c *Client) GetSpriteImage(img ImageData) (image.Image, error) {
+	sprite, err := c.getSprite(img.Sprite)
+	if err != nil {
+		return nil, err
+	}
+	subImager, ok := sprite.(interface {
+		SubImage(r image.Rectangle) image.Image
+	})
+	if !ok {
+		return nil, fmt.Errorf("sprite %s can not be cropped", img.Sprite)
+	}
+	bounds := sprite.Bounds()
+	rect := image.Rect(img.X, img.Y, img.X+img.W, img.Y+img.H).Add(bounds.Min)
+	if !rect.In(bounds) {
+		return nil, fmt.Errorf("image %s is out of bounds of sprite %s", rect, img.Sprite)
+	}
+	return subImager.SubImage(rect), nil
+}
+
+func (c *Client) getSprite(name string) (image.Image, error) {
+	unlock, toggle := internal.RWLockToggle(&c.spritesMu)
+	defer unlock()
+	sprite, ok := c.sprites[name]
+	if !ok {
+		toggle()
+		var err error
+		sprite, err = c.downloadImage(ImageData{Sprite: name}.SpriteURL(c))
+		if err != nil {
+			return nil, err
+		}
+		if c.sprites == nil {
+			c.sprites = map[string]image.Image{}
+		}
+		c.sprites[name] = sprite
+	}
+	return sprite, nil
+}
+
+func (c *Client) downloadImage(url string) (image.Image, error) {
+	response, err := c.do(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	img, _, err := image.Decode(response.Body)
+	return img, err
+}
+
+func (c *Client) imageURL(path string) string {
+	return c.baseURL + fmt.Sprintf(string(dataDragonImageURLFormat), c.Version) + "/" + path
+}
+
+func (c *Client) championArtURL(kind, championID string, num int) string {
+	return c.baseURL + fmt.Sprintf(dataDragonChampionArtURLFormat, kind, championID, num)
+}
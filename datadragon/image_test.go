@@ -0,0 +1,184 @@
+package datadragon
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func newImageTestClient(doer *mock.Doer) *Client {
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	c.Version = "14.3.1"
+	return c
+}
+
+func TestImageURLs(t *testing.T) {
+	t.Parallel()
+	c := newImageTestClient(mock.NewStatusMockDoer(http.StatusNotFound))
+	const base = "https://ddragon.leagueoflegends.com"
+	champion := ChampionData{ID: "Ashe", Image: ImageData{Full: "Ashe.png", Sprite: "champion0.png", Group: "champion"}}
+	skin := SkinData{Num: 3}
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "champion",
+			got:  champion.ImageURL(c),
+			want: base + "/cdn/14.3.1/img/champion/Ashe.png",
+		},
+		{
+			name: "sprite",
+			got:  champion.Image.SpriteURL(c),
+			want: base + "/cdn/14.3.1/img/sprite/champion0.png",
+		},
+		{
+			name: "splash",
+			got:  skin.SplashURL(c, champion.ID),
+			want: base + "/cdn/img/champion/splash/Ashe_3.jpg",
+		},
+		{
+			name: "loading",
+			got:  skin.LoadingURL(c, champion.ID),
+			want: base + "/cdn/img/champion/loading/Ashe_3.jpg",
+		},
+		{
+			name: "tile",
+			got:  skin.TileURL(c, champion.ID),
+			want: base + "/cdn/img/champion/tiles/Ashe_3.jpg",
+		},
+		{
+			name: "spell",
+			got:  (&SpellData{Image: ImageData{Full: "AsheQ.png", Group: "spell"}}).ImageURL(c),
+			want: base + "/cdn/14.3.1/img/spell/AsheQ.png",
+		},
+		{
+			name: "passive",
+			got:  (&PassiveData{Image: ImageData{Full: "Ashe_P.png", Group: "passive"}}).ImageURL(c),
+			want: base + "/cdn/14.3.1/img/passive/Ashe_P.png",
+		},
+		{
+			name: "profile icon",
+			got:  (&ProfileIcon{Image: ImageData{Full: "588.png", Group: "profileicon"}}).ImageURL(c),
+			want: base + "/cdn/14.3.1/img/profileicon/588.png",
+		},
+		{
+			name: "item",
+			got:  (&Item{Image: ImageData{Full: "1001.png", Group: "item"}}).ImageURL(c),
+			want: base + "/cdn/14.3.1/img/item/1001.png",
+		},
+		{
+			name: "summoner spell",
+			got:  (&SummonerSpell{Image: ImageData{Full: "SummonerFlash.png", Group: "spell"}}).ImageURL(c),
+			want: base + "/cdn/14.3.1/img/spell/SummonerFlash.png",
+		},
+		{
+			name: "rune path",
+			got:  RunePath{Icon: "perk-images/Styles/7201_Precision.png"}.IconURL(c),
+			want: base + "/cdn/img/perk-images/Styles/7201_Precision.png",
+		},
+		{
+			name: "rune",
+			got:  (&RuneReforged{Icon: "perk-images/Styles/Precision/Conqueror/Conqueror.png"}).IconURL(c),
+			want: base + "/cdn/img/perk-images/Styles/Precision/Conqueror/Conqueror.png",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.got)
+			},
+		)
+	}
+}
+
+// testSprite returns a PNG encoded 4x2 image whose left half is red and right half is blue
+func testSprite(t *testing.T) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		for y := 0; y < 2; y++ {
+			c := color.RGBA{R: 255, A: 255}
+			if x >= 2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func bytesDoer(content []byte, requested *[]string) *mock.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			*requested = append(*requested, r.URL.String())
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(content)),
+			}, nil
+		},
+	}
+}
+
+func TestClient_Download(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	c := newImageTestClient(bytesDoer([]byte("content"), &requested))
+	got, err := c.Download("https://example.com/file")
+	require.Nil(t, err)
+	assert.Equal(t, []byte("content"), got)
+	assert.Equal(t, "https://example.com/file", requested[len(requested)-1])
+
+	c = newImageTestClient(mock.NewStatusMockDoer(http.StatusNotFound))
+	_, err = c.Download("https://example.com/file")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetImage(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	c := newImageTestClient(bytesDoer(testSprite(t), &requested))
+	img, err := c.GetImage(ImageData{Full: "Ashe.png", Group: "champion"})
+	require.Nil(t, err)
+	assert.Equal(t, image.Rect(0, 0, 4, 2), img.Bounds())
+	assert.Equal(t, "https://ddragon.leagueoflegends.com/cdn/14.3.1/img/champion/Ashe.png", requested[len(requested)-1])
+
+	_, err = newImageTestClient(bytesDoer([]byte("no image"), &requested)).GetImage(ImageData{})
+	assert.Equal(t, image.ErrFormat, err)
+}
+
+func TestClient_GetSpriteImage(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	c := newImageTestClient(bytesDoer(testSprite(t), &requested))
+	requested = nil
+	left, err := c.GetSpriteImage(ImageData{Sprite: "champion0.png", X: 0, Y: 0, W: 2, H: 2})
+	require.Nil(t, err)
+	right, err := c.GetSpriteImage(ImageData{Sprite: "champion0.png", X: 2, Y: 0, W: 2, H: 2})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"https://ddragon.leagueoflegends.com/cdn/14.3.1/img/sprite/champion0.png"}, requested)
+	assert.Equal(t, 2, left.Bounds().Dx())
+	_, _, b, _ := left.At(left.Bounds().Min.X, left.Bounds().Min.Y).RGBA()
+	assert.Zero(t, b)
+	r, _, _, _ := right.At(right.Bounds().Min.X, right.Bounds().Min.Y).RGBA()
+	assert.Zero(t, r)
+
+	_, err = c.GetSpriteImage(ImageData{Sprite: "champion0.png", X: 3, Y: 0, W: 2, H: 2})
+	assert.Error(t, err)
+	c.ClearCaches()
+	_, err = newImageTestClient(mock.NewStatusMockDoer(http.StatusNotFound)).GetSpriteImage(ImageData{})
+	assert.Equal(t, api.ErrNotFound, err)
+}
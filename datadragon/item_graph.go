@@ -0,0 +1,206 @@
+package datadragon
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// StatGoldValues contains the gold value of one unit of each stat. The values are derived from the basic items
+// providing only that stat, e.g. Long Sword costs 350 gold for 10 attack damage. Percentage stats are given as
+// fractions in Data Dragon, so one unit is 100%.
+var StatGoldValues = ItemStats{
+	FlatPhysicalDamageMod:   35,
+	FlatMagicDamageMod:      20,
+	FlatHPPoolMod:           8.0 / 3,
+	FlatMPPoolMod:           1.4,
+	FlatArmorMod:            20,
+	FlatSpellBlockMod:       18,
+	PercentAttackSpeedMod:   2500,
+	FlatCritChanceMod:       4000,
+	FlatMovementSpeedMod:    12,
+	PercentMovementSpeedMod: 3950,
+	PercentLifeStealMod:     3750,
+}
+
+// GoldValue returns the value of the stats in gold according to StatGoldValues
+func (s *ItemStats) GoldValue() float64 {
+	v := &StatGoldValues
+	return s.FlatPhysicalDamageMod*v.FlatPhysicalDamageMod +
+		s.FlatMagicDamageMod*v.FlatMagicDamageMod +
+		s.FlatHPPoolMod*v.FlatHPPoolMod +
+		s.FlatMPPoolMod*v.FlatMPPoolMod +
+		s.FlatArmorMod*v.FlatArmorMod +
+		s.FlatSpellBlockMod*v.FlatSpellBlockMod +
+		s.PercentAttackSpeedMod*v.PercentAttackSpeedMod +
+		s.FlatCritChanceMod*v.FlatCritChanceMod +
+		s.FlatMovementSpeedMod*v.FlatMovementSpeedMod +
+		s.PercentMovementSpeedMod*v.PercentMovementSpeedMod +
+		s.PercentLifeStealMod*v.PercentLifeStealMod
+}
+
+// RecipeNode is an item together with the recipe trees of the items it is built from
+type RecipeNode struct {
+	Item       Item
+	Components []RecipeNode
+}
+
+// ItemGraph provides navigation between items and the items they are built from or build into
+type ItemGraph struct {
+	items map[string]Item
+}
+
+// NewItemGraph returns a new graph of the given items
+func NewItemGraph(items []Item) *ItemGraph {
+	g := &ItemGraph{items: make(map[string]Item, len(items))}
+	for _, item := range items {
+		g.items[item.ID] = item
+	}
+	return g
+}
+
+// GetItemGraph returns the graph of all existing items
+func (c *Client) GetItemGraph() (*ItemGraph, error) {
+	items, err := c.GetItems()
+	if err != nil {
+		return nil, err
+	}
+	return NewItemGraph(items), nil
+}
+
+// Item returns the item with the given ID
+func (g *ItemGraph) Item(id string) (Item, error) {
+	item, ok := g.items[id]
+	if !ok {
+		return Item{}, api.ErrNotFound
+	}
+	return item, nil
+}
+
+// Components returns the items the item with the given ID is directly built from
+func (g *ItemGraph) Components(id string) ([]Item, error) {
+	item, err := g.Item(id)
+	if err != nil {
+		return nil, err
+	}
+	return g.resolve(item.From), nil
+}
+
+// BuildsInto returns the items the item with the given ID is directly used in
+func (g *ItemGraph) BuildsInto(id string) ([]Item, error) {
+	item, err := g.Item(id)
+	if err != nil {
+		return nil, err
+	}
+	return g.resolve(item.Into), nil
+}
+
+// RecipeTree returns the full recipe tree of the item with the given ID down to the basic items
+func (g *ItemGraph) RecipeTree(id string) (RecipeNode, error) {
+	item, err := g.Item(id)
+	if err != nil {
+		return RecipeNode{}, err
+	}
+	return g.recipeTree(&item, map[string]bool{}), nil
+}
+
+func (g *ItemGraph) recipeTree(item *Item, visited map[string]bool) RecipeNode {
+	node := RecipeNode{Item: *item}
+	visited[item.ID] = true
+	defer delete(visited, item.ID)
+	for _, component := range g.resolve(item.From) {
+		if visited[component.ID] {
+			continue
+		}
+		node.Components = append(node.Components, g.recipeTree(&component, visited))
+	}
+	return node
+}
+
+// TotalCost returns the gold needed to buy the item with the given ID including all of its components
+func (g *ItemGraph) TotalCost(id string) (int, error) {
+	item, err := g.Item(id)
+	if err != nil {
+		return 0, err
+	}
+	return item.Gold.Total, nil
+}
+
+// CombineCost returns the gold needed to combine the components of the item with the given ID into the item
+func (g *ItemGraph) CombineCost(id string) (int, error) {
+	item, err := g.Item(id)
+	if err != nil {
+		return 0, err
+	}
+	return item.Gold.Base, nil
+}
+
+// ItemsOnMap returns all items available on the map with the given ID, sorted by ID
+func (g *ItemGraph) ItemsOnMap(mapID int) []Item {
+	key := strconv.Itoa(mapID)
+	return g.filter(
+		func(item *Item) bool {
+			return item.Maps[key]
+		},
+	)
+}
+
+// CompletedItems returns all purchasable items which are built from other items but are not used in any other
+// item, sorted by ID
+func (g *ItemGraph) CompletedItems() []Item {
+	return g.filter(
+		func(item *Item) bool {
+			return item.Gold.Purchasable && len(item.From) > 0 && len(g.resolve(item.Into)) == 0
+		},
+	)
+}
+
+// ComponentItems returns all purchasable items which are used to build other items, sorted by ID
+func (g *ItemGraph) ComponentItems() []Item {
+	return g.filter(
+		func(item *Item) bool {
+			return item.Gold.Purchasable && len(g.resolve(item.Into)) > 0
+		},
+	)
+}
+
+// GoldEfficiency returns the gold value of the stats of the item with the given ID divided by its total cost.
+// A value of 1 means the stats alone are worth the price. Items without cost have an efficiency of 0.
+func (g *ItemGraph) GoldEfficiency(id string) (float64, error) {
+	item, err := g.Item(id)
+	if err != nil {
+		return 0, err
+	}
+	if item.Gold.Total == 0 {
+		return 0, nil
+	}
+	return item.Stats.GoldValue() / float64(item.Gold.Total), nil
+}
+
+// resolve returns the items with the given IDs, skipping unknown IDs
+func (g *ItemGraph) resolve(ids []string) []Item {
+	res := make([]Item, 0, len(ids))
+	for _, id := range ids {
+		if item, ok := g.items[id]; ok {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+func (g *ItemGraph) filter(keep func(item *Item) bool) []Item {
+	var res []Item
+	for id := range g.items {
+		item := g.items[id]
+		if keep(&item) {
+			res = append(res, item)
+		}
+	}
+	sort.Slice(
+		res, func(i, j int) bool {
+			return res[i].ID < res[j].ID
+		},
+	)
+	return res
+}
@@ -0,0 +1,143 @@
+package datadragon
+
+import (
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func testItem(id string, base, total int, from, into []string, maps ...string) Item {
+	item := Item{ID: id, From: from, Into: into, Maps: map[string]bool{}}
+	item.Gold.Base = base
+	item.Gold.Total = total
+	item.Gold.Purchasable = true
+	for _, m := range maps {
+		item.Maps[m] = true
+	}
+	return item
+}
+
+func testItemGraph() *ItemGraph {
+	longSword := testItem("1036", 350, 350, nil, []string{"3133", "6692"}, "11", "12")
+	longSword.Stats.FlatPhysicalDamageMod = 10
+	caulfields := testItem("3133", 400, 1100, []string{"1036", "1036"}, []string{"6692"}, "11", "12")
+	caulfields.Stats.FlatPhysicalDamageMod = 25
+	eclipse := testItem("6692", 850, 2800, []string{"3133", "1036", "9999"}, nil, "11")
+	eclipse.Stats.FlatPhysicalDamageMod = 70
+	trinket := testItem("3340", 0, 0, nil, nil, "11")
+	trinket.Gold.Purchasable = false
+	return NewItemGraph([]Item{longSword, caulfields, eclipse, trinket})
+}
+
+func TestItemGraph_Navigation(t *testing.T) {
+	t.Parallel()
+	g := testItemGraph()
+	components, err := g.Components("6692")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"3133", "1036"}, itemIDs(components))
+	into, err := g.BuildsInto("1036")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"3133", "6692"}, itemIDs(into))
+	_, err = g.Components("9999")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = g.BuildsInto("9999")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	assert.Equal(t, []string{"1036", "3133", "3340", "6692"}, itemIDs(g.ItemsOnMap(11)))
+	assert.Equal(t, []string{"1036", "3133"}, itemIDs(g.ItemsOnMap(12)))
+	assert.Equal(t, []string{"6692"}, itemIDs(g.CompletedItems()))
+	assert.Equal(t, []string{"1036", "3133"}, itemIDs(g.ComponentItems()))
+}
+
+func TestItemGraph_RecipeTree(t *testing.T) {
+	t.Parallel()
+	g := testItemGraph()
+	tree, err := g.RecipeTree("6692")
+	require.Nil(t, err)
+	assert.Equal(t, "6692", tree.Item.ID)
+	require.Len(t, tree.Components, 2)
+	assert.Equal(t, "3133", tree.Components[0].Item.ID)
+	assert.Equal(t, []string{"1036", "1036"}, recipeIDs(tree.Components[0].Components))
+	assert.Equal(t, "1036", tree.Components[1].Item.ID)
+	assert.Empty(t, tree.Components[1].Components)
+	_, err = g.RecipeTree("9999")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	looping := NewItemGraph([]Item{{ID: "1", From: []string{"2"}}, {ID: "2", From: []string{"1"}}})
+	tree, err = looping.RecipeTree("1")
+	require.Nil(t, err)
+	require.Len(t, tree.Components, 1)
+	assert.Empty(t, tree.Components[0].Components)
+}
+
+func TestItemGraph_Costs(t *testing.T) {
+	t.Parallel()
+	g := testItemGraph()
+	total, err := g.TotalCost("6692")
+	require.Nil(t, err)
+	assert.Equal(t, 2800, total)
+	combine, err := g.CombineCost("6692")
+	require.Nil(t, err)
+	assert.Equal(t, 850, combine)
+	_, err = g.TotalCost("9999")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = g.CombineCost("9999")
+	assert.Equal(t, api.ErrNotFound, err)
+
+	efficiency, err := g.GoldEfficiency("1036")
+	require.Nil(t, err)
+	assert.InDelta(t, 1, efficiency, 1e-9)
+	efficiency, err = g.GoldEfficiency("6692")
+	require.Nil(t, err)
+	assert.InDelta(t, 0.875, efficiency, 1e-9)
+	efficiency, err = g.GoldEfficiency("3340")
+	require.Nil(t, err)
+	assert.Zero(t, efficiency)
+	_, err = g.GoldEfficiency("9999")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestItemStats_GoldValue(t *testing.T) {
+	t.Parallel()
+	stats := ItemStats{FlatHPPoolMod: 150, PercentAttackSpeedMod: 0.1, FlatCritChanceMod: 0.15}
+	assert.InDelta(t, 400+250+600, stats.GoldValue(), 1e-9)
+}
+
+func TestClient_GetItemGraph(t *testing.T) {
+	t.Parallel()
+	c := NewClient(
+		dataDragonResponseDoer(map[string]Item{"1036": {Name: "Long Sword"}}), api.RegionEuropeWest,
+		log.StandardLogger(),
+	)
+	g, err := c.GetItemGraph()
+	require.Nil(t, err)
+	item, err := g.Item("1036")
+	require.Nil(t, err)
+	assert.Equal(t, "Long Sword", item.Name)
+
+	c = NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger())
+	_, err = c.GetItemGraph()
+	assert.Equal(t, api.ErrForbidden, err)
+}
+
+func itemIDs(items []Item) []string {
+	res := make([]string, 0, len(items))
+	for _, item := range items {
+		res = append(res, item.ID)
+	}
+	return res
+}
+
+func recipeIDs(nodes []RecipeNode) []string {
+	res := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		res = append(res, node.Item.ID)
+	}
+	return res
+}
@@ -65,7 +65,10 @@ type ChampionDataStats struct {
 	AttackDamage                    float64 `json:"attackdamage"`
 	AttackDamagePerLevel            float64 `json:"attackdamageperlevel"`
 	AttackSpeedOffset               float64 `json:"attackspeedoffset"`
-	AttackSpeedPerLevel             float64 `json:"attackspeedperlevel"`
+	// AttackSpeedPerLevel is the bonus attack speed in percent gained per level
+	AttackSpeedPerLevel float64 `json:"attackspeedperlevel"`
+	// AttackSpeed is the base attack speed. Versions before 10.10 only provide the AttackSpeedOffset.
+	AttackSpeed float64 `json:"attackspeed"`
 }
 
 // ChampionDataExtended contains additional data about a champion
@@ -194,6 +197,7 @@ type Item struct {
 	Stats            ItemStats       `json:"stats"`
 	Tags             []string        `json:"tags"`
 	Maps             map[string]bool `json:"maps"`
+	Image            ImageData       `json:"image"`
 }
 
 // ItemStats contains information about the stats of an item
@@ -318,3 +322,35 @@ type SummonerSpell struct {
 	Image         ImageData `json:"image"`
 	Resource      string    `json:"resource"`
 }
+
+// RunePath contains information about a rune path, also known as style, e.g. Precision
+type RunePath struct {
+	ID    int        `json:"id"`
+	Key   string     `json:"key"`
+	Icon  string     `json:"icon"`
+	Name  string     `json:"name"`
+	Slots []RuneSlot `json:"slots"`
+}
+
+// RuneSlot is a row of runes in a rune path of which one can be chosen. The first slot contains the keystones.
+type RuneSlot struct {
+	Runes []RuneReforged `json:"runes"`
+}
+
+// RuneReforged contains information about a rune
+type RuneReforged struct {
+	ID        int    `json:"id"`
+	Key       string `json:"key"`
+	Icon      string `json:"icon"`
+	Name      string `json:"name"`
+	ShortDesc string `json:"shortDesc"`
+	LongDesc  string `json:"longDesc"`
+}
+
+// StatShard contains information about a stat shard. Stat shards are not part of runesReforged.json, so they are
+// not localized.
+type StatShard struct {
+	ID          int
+	Name        string
+	Description string
+}
@@ -0,0 +1,30 @@
+package datadragon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_normalizeName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Ashe", want: "ashe"},
+		{name: "Kai'Sa", want: "kaisa"},
+		{name: "Dr. Mundo", want: "drmundo"},
+		{name: "Nunu & Willump", want: "nunuwillump"},
+		{name: "Nidalée", want: "nidalee"},
+		{name: "ÄÖÜ ß", want: "aouss"},
+		{name: "아트록스", want: "아트록스"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, normalizeName(tt.name))
+			},
+		)
+	}
+}
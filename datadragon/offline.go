@@ -0,0 +1,307 @@
+package datadragon
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WithFS makes the client load all data and images from the given file system instead of the CDN. The file
+// system must have the layout of an extracted dragontail archive, i.e. contain directories like
+// "14.3.1/data/en_US" and "img/champion". The newest version in the file system is used by default.
+func WithFS(fsys fs.FS) Option {
+	return func(c *Client) {
+		c.client = &fsDoer{fsys: fsys}
+	}
+}
+
+// WithDirectory makes the client load all data and images from the given directory, e.g. an extracted
+// dragontail archive. See WithFS.
+func WithDirectory(dir string) Option {
+	return WithFS(os.DirFS(dir))
+}
+
+// OpenDragontail reads the data files of the dragontail-<version>.tgz archive at the given path into memory.
+// The returned file system can be used with WithFS. See ReadDragontail.
+func OpenDragontail(name string) (fs.FS, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ReadDragontail(file)
+}
+
+// ReadDragontail reads the data files of a gzip compressed dragontail tar archive into memory, i.e. the JSON
+// files in the "<version>/data" directories. Images make up most of an archive and are skipped, so a client using
+// the returned file system can not load images. Use WithDirectory with an extracted archive to serve images.
+// The returned file system can be used with WithFS.
+func ReadDragontail(r io.Reader) (fs.FS, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+	files := memFS{}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(header.Name, "./"))
+		if !fs.ValidPath(name) || !isDragontailData(name) {
+			continue
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+	}
+	return files, nil
+}
+
+// isDragontailData returns whether the file with the given name is a JSON file of a data directory, e.g.
+// "14.3.1/data/en_US/champion/Ashe.json"
+func isDragontailData(name string) bool {
+	parts := strings.SplitN(name, "/", 3)
+	return len(parts) == 3 && parts[1] == "data" && path.Ext(name) == ".json"
+}
+
+// fsDoer serves Data Dragon requests from a file system. The realm and versions files are not part of dragontail
+// archives and are generated from the versions found in the file system.
+type fsDoer struct {
+	fsys fs.FS
+}
+
+// Do returns the file matching the path of the request or a response with status 404 if there is none
+func (d *fsDoer) Do(request *http.Request) (*http.Response, error) {
+	p := request.URL.Path
+	var data []byte
+	var err error
+	switch {
+	case strings.HasSuffix(p, "/api/versions.json"):
+		data, err = d.versionsFile()
+	case strings.Contains(p, "/realms/"):
+		data, err = d.realmFile()
+	case strings.Contains(p, "/cdn/"):
+		data, err = fs.ReadFile(d.fsys, p[strings.Index(p, "/cdn/")+len("/cdn/"):])
+	default:
+		err = fs.ErrNotExist
+	}
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrInvalid) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+			Request:    request,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(data)),
+		Request:    request,
+	}, nil
+}
+
+// versions returns all versions in the file system, newest first. A version is a top level directory
+// containing a data directory.
+func (d *fsDoer) versions() ([]string, error) {
+	entries, err := fs.ReadDir(d.fsys, ".")
+	if err != nil {
+		return nil, err
+	}
+	var versions []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if info, err := fs.Stat(d.fsys, entry.Name()+"/data"); err == nil && info.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	sort.Slice(
+		versions, func(i, j int) bool {
+			return versionGreaterThan(versions[i], versions[j])
+		},
+	)
+	return versions, nil
+}
+
+func (d *fsDoer) versionsFile() ([]byte, error) {
+	versions, err := d.versions()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(versions)
+}
+
+// realmFile returns a realm file for the newest version, preferring the fallback language if it is available
+func (d *fsDoer) realmFile() ([]byte, error) {
+	versions, err := d.versions()
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, fs.ErrNotExist
+	}
+	languages, err := fs.ReadDir(d.fsys, versions[0]+"/data")
+	if err != nil {
+		return nil, err
+	}
+	if len(languages) == 0 {
+		return nil, fs.ErrNotExist
+	}
+	language := languages[0].Name()
+	for _, l := range languages {
+		if l.Name() == string(fallbackLanguage) {
+			language = l.Name()
+		}
+	}
+	return json.Marshal(
+		struct {
+			Version  string `json:"v"`
+			Language string `json:"l"`
+		}{Version: versions[0], Language: language},
+	)
+}
+
+// memFS is a read-only in-memory file system holding file contents by their slash separated path
+type memFS map[string][]byte
+
+// Open opens the named file or directory
+func (m memFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+	if data, ok := m[name]; ok {
+		info := memFileInfo{name: path.Base(name), size: int64(len(data))}
+		return &memFile{info: info, reader: bytes.NewReader(data)}, nil
+	}
+	entries, err := m.ReadDir(name)
+	if err != nil {
+		return nil, err
+	}
+	return &memFile{info: memFileInfo{name: path.Base(name), dir: true}, entries: entries}, nil
+}
+
+// ReadDir returns the entries of the named directory sorted by name
+func (m memFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	prefix := name + "/"
+	if name == "." {
+		prefix = ""
+	}
+	children := map[string]memFileInfo{}
+	for file, data := range m {
+		if !strings.HasPrefix(file, prefix) {
+			continue
+		}
+		rest := file[len(prefix):]
+		if i := strings.Index(rest, "/"); i >= 0 {
+			children[rest[:i]] = memFileInfo{name: rest[:i], dir: true}
+		} else {
+			children[rest] = memFileInfo{name: rest, size: int64(len(data))}
+		}
+	}
+	if len(children) == 0 {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	entries := make([]fs.DirEntry, 0, len(children))
+	for _, info := range children {
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	sort.Slice(
+		entries, func(i, j int) bool {
+			return entries[i].Name() < entries[j].Name()
+		},
+	)
+	return entries, nil
+}
+
+type memFile struct {
+	info    memFileInfo
+	reader  *bytes.Reader
+	entries []fs.DirEntry
+}
+
+func (f *memFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *memFile) Read(p []byte) (int, error) {
+	if f.info.dir {
+		return 0, &fs.PathError{Op: "read", Path: f.info.name, Err: fs.ErrInvalid}
+	}
+	return f.reader.Read(p)
+}
+
+func (f *memFile) Close() error {
+	return nil
+}
+
+// ReadDir makes directories opened from a memFS usable with fs.ReadDir and fs.WalkDir
+func (f *memFile) ReadDir(n int) ([]fs.DirEntry, error) {
+	if n > 0 && len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+	if n <= 0 || n >= len(f.entries) {
+		entries := f.entries
+		f.entries = nil
+		return entries, nil
+	}
+	entries := f.entries[:n]
+	f.entries = f.entries[n:]
+	return entries, nil
+}
+
+type memFileInfo struct {
+	name string
+	size int64
+	dir  bool
+}
+
+func (i memFileInfo) Name() string {
+	return i.name
+}
+
+func (i memFileInfo) Size() int64 {
+	return i.size
+}
+
+func (i memFileInfo) Mode() fs.FileMode {
+	if i.dir {
+		return fs.ModeDir | 0o555
+	}
+	return 0o444
+}
+
+func (i memFileInfo) ModTime() time.Time {
+	return time.Time{}
+}
+
+func (i memFileInfo) IsDir() bool {
+	return i.dir
+}
+
+func (i memFileInfo) Sys() any {
+	return nil
+}
@@ -0,0 +1,172 @@
+package datadragon
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// testDragontailFiles returns the files of a minimal dragontail archive with two versions
+func testDragontailFiles(t *testing.T) map[string][]byte {
+	data := func(object any) []byte {
+		content, err := json.Marshal(dataDragonResponse{Data: object})
+		require.NoError(t, err)
+		return content
+	}
+	runes, err := json.Marshal(testRunePaths)
+	require.NoError(t, err)
+	return map[string][]byte{
+		"14.3.1/data/en_US/champion.json": data(
+			map[string]ChampionData{"Ashe": {ID: "Ashe", Key: "22", Name: "Ashe"}},
+		),
+		"14.3.1/data/de_DE/champion.json": data(
+			map[string]ChampionData{"Ashe": {ID: "Ashe", Key: "22", Name: "Ashe (de)"}},
+		),
+		"14.3.1/data/en_US/champion/Ashe.json": data(
+			map[string]ChampionDataExtended{"Ashe": {ChampionData: ChampionData{ID: "Ashe", Key: "22", Name: "Ashe"}}},
+		),
+		"14.3.1/data/de_DE/champion/Ashe.json": data(
+			map[string]ChampionDataExtended{
+				"Ashe": {ChampionData: ChampionData{ID: "Ashe", Key: "22", Name: "Ashe (de)"}},
+			},
+		),
+		"14.3.1/data/en_US/item.json":          data(map[string]Item{"1001": {Name: "Boots"}}),
+		"14.3.1/data/en_US/summoner.json":      data(map[string]SummonerSpell{"SummonerFlash": {Key: "4"}}),
+		"14.3.1/data/en_US/runesReforged.json": runes,
+		"14.3.1/img/item/1001.png":             testSprite(t),
+		"9.3.1/data/en_US/item.json":           data(map[string]Item{"1001": {Name: "Old Boots"}}),
+		"img/champion/splash/Ashe_0.jpg":       []byte("splash"),
+		"languages.json":                       []byte(`["en_US","de_DE"]`),
+	}
+}
+
+func testDragontail(t *testing.T) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "./14.3.1/", Typeflag: tar.TypeDir, Mode: 0o755}))
+	for name, content := range testDragontailFiles(t) {
+		require.NoError(
+			t, tw.WriteHeader(
+				&tar.Header{Name: "./" + name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(content))},
+			),
+		)
+		_, err := tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// testOfflineClient tests a client serving testDragontailFiles. If images is false, the client must not serve
+// images.
+func testOfflineClient(t *testing.T, c *Client, images bool) {
+	assert.Equal(t, "14.3.1", c.Version)
+	assert.Equal(t, languageCode(LanguageCodeUnitedStates), c.Language)
+
+	champion, err := c.GetChampionByKey(22)
+	require.Nil(t, err)
+	assert.Equal(t, "Ashe", champion.Name)
+	champion, err = c.ForLanguage(LanguageCodeGermany).GetChampionByKey(22)
+	require.Nil(t, err)
+	assert.Equal(t, "Ashe (de)", champion.Name)
+
+	item, err := c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "Boots", item.Name)
+	item, err = c.ForVersion("9.3.1").GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "Old Boots", item.Name)
+
+	spell, err := c.GetSummonerSpell("4")
+	require.Nil(t, err)
+	assert.Equal(t, "4", spell.Key)
+	perk, err := c.GetRuneReforged(9101)
+	require.Nil(t, err)
+	assert.Equal(t, 9101, perk.ID)
+
+	img, err := c.GetImage(ImageData{Full: "1001.png", Group: "item"})
+	splash, splashErr := c.Download(SkinData{}.SplashURL(c, "Ashe"))
+	if images {
+		require.Nil(t, err)
+		assert.Equal(t, 4, img.Bounds().Dx())
+		require.Nil(t, splashErr)
+		assert.Equal(t, []byte("splash"), splash)
+	} else {
+		assert.Equal(t, api.ErrNotFound, err)
+		assert.Equal(t, api.ErrNotFound, splashErr)
+	}
+
+	versions, err := c.GetVersions()
+	require.Nil(t, err)
+	assert.Equal(t, []string{"14.3.1", "9.3.1"}, versions)
+
+	_, err = c.GetProfileIcons()
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.Download("https://ddragon.leagueoflegends.com/unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.Download("https://ddragon.leagueoflegends.com/cdn/../secret")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestReadDragontail(t *testing.T) {
+	t.Parallel()
+	fsys, err := ReadDragontail(bytes.NewReader(testDragontail(t)))
+	require.NoError(t, err)
+	testOfflineClient(t, NewClient(nil, api.RegionEuropeWest, log.StandardLogger(), WithFS(fsys)), false)
+
+	entries, err := fs.ReadDir(fsys, "14.3.1/data")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "de_DE", entries[0].Name())
+	assert.True(t, entries[0].IsDir())
+	_, err = fs.Stat(fsys, "14.3.1/img/item/1001.png")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = fs.Stat(fsys, "languages.json")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = fsys.Open("unknown")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = fsys.Open("/invalid")
+	assert.ErrorIs(t, err, fs.ErrInvalid)
+
+	_, err = ReadDragontail(bytes.NewReader([]byte("no archive")))
+	assert.Error(t, err)
+}
+
+func TestOpenDragontail(t *testing.T) {
+	t.Parallel()
+	name := filepath.Join(t.TempDir(), "dragontail-14.3.1.tgz")
+	require.NoError(t, os.WriteFile(name, testDragontail(t), 0o600))
+	fsys, err := OpenDragontail(name)
+	require.NoError(t, err)
+	testOfflineClient(t, NewClient(nil, api.RegionEuropeWest, log.StandardLogger(), WithFS(fsys)), false)
+
+	_, err = OpenDragontail(filepath.Join(t.TempDir(), "unknown.tgz"))
+	assert.Error(t, err)
+}
+
+func TestWithDirectory(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	for name, content := range testDragontailFiles(t) {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+	}
+	testOfflineClient(t, NewClient(nil, api.RegionEuropeWest, log.StandardLogger(), WithDirectory(dir)), true)
+
+	c := NewClient(nil, api.RegionEuropeWest, log.StandardLogger(), WithDirectory(t.TempDir()))
+	assert.Equal(t, fallbackVersion, c.Version)
+}
@@ -0,0 +1,111 @@
+package datadragon
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// PatchChangedFunc is called by a Refresher after it switched to a new version
+type PatchChangedFunc func(oldVersion, newVersion string)
+
+// Refresher keeps a Data Dragon client up to date with the current version of its region.
+// It periodically re-reads the realm file and, when the version changed, atomically replaces the client returned
+// by Client with one for the new version. Clients for previous versions stay registered and keep their data and
+// caches, so ForVersion returns them without requesting their data again.
+type Refresher struct {
+	current   atomic.Pointer[Client]
+	interval  time.Duration
+	onChange  PatchChangedFunc
+	mu        sync.Mutex
+	lastErr   error
+	stop      chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// NewRefresher returns a new refresher starting with the given client, which checks for a new version in the
+// given interval once started. The callback may be nil.
+// If the client could not load the realm during construction, see Client.InitError, the first refresh replaces
+// the fallback version with the current one.
+func NewRefresher(client *Client, interval time.Duration, onChange PatchChangedFunc) *Refresher {
+	r := &Refresher{
+		interval: interval,
+		onChange: onChange,
+		stop:     make(chan struct{}),
+	}
+	r.current.Store(client)
+	return r
+}
+
+// Client returns the client for the current version
+func (r *Refresher) Client() *Client {
+	return r.current.Load()
+}
+
+// Refresh re-reads the realm file and switches to a client for the new version if the version changed.
+// The cached list of versions used by GetVersions and ForGameVersion is cleared so that new patches are found.
+// It reports whether the version changed.
+func (r *Refresher) Refresh() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	old := r.current.Load()
+	old.registry.resetVersions()
+	version, language, err := old.getRealm()
+	r.lastErr = err
+	if err != nil {
+		old.logger.WithError(err).Warn("could not refresh realm")
+		return false, err
+	}
+	if version == old.Version {
+		return false, nil
+	}
+	// keep the language chosen for the client unless it is the fallback language
+	if old.initErr == nil {
+		language = old.Language
+	}
+	r.current.Store(old.registry.get(old, version, language))
+	old.logger.Infof("switched from version %s to %s", old.Version, version)
+	if r.onChange != nil {
+		r.onChange(old.Version, version)
+	}
+	return true, nil
+}
+
+// LastError returns the error of the last refresh or nil if it succeeded
+func (r *Refresher) LastError() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastErr
+}
+
+// Start starts refreshing in the background until Stop is called. Calling Start more than once has no effect.
+func (r *Refresher) Start() {
+	r.startOnce.Do(
+		func() {
+			go r.run()
+		},
+	)
+}
+
+// Stop stops refreshing in the background
+func (r *Refresher) Stop() {
+	r.stopOnce.Do(
+		func() {
+			close(r.stop)
+		},
+	)
+}
+
+func (r *Refresher) run() {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			_, _ = r.Refresh()
+		}
+	}
+}
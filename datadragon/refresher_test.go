@@ -0,0 +1,138 @@
+package datadragon
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// realmDoer serves a realm file and a version list with the stored version and items named after the requested
+// version. If the stored version is empty, the realm file can not be found.
+func realmDoer(version *atomic.Value) *mock.Doer {
+	return &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == "/realms/euw.json" {
+				v := version.Load().(string)
+				if v == "" {
+					return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+				}
+				return mock.NewJSONMockDoer(map[string]string{"v": v, "l": "de_DE"}, 200).Do(r)
+			}
+			if r.URL.Path == "/api/versions.json" {
+				return mock.NewJSONMockDoer([]string{version.Load().(string), "14.3.1"}, 200).Do(r)
+			}
+			return mock.NewJSONMockDoer(
+				dataDragonResponse{Data: map[string]Item{"1001": {Name: r.URL.Path}}}, 200,
+			).Do(r)
+		},
+	}
+}
+
+func TestRefresher_Refresh(t *testing.T) {
+	t.Parallel()
+	var version atomic.Value
+	version.Store("14.3.1")
+	c := NewClient(realmDoer(&version), api.RegionEuropeWest, log.StandardLogger())
+	require.Nil(t, c.InitError())
+	c = c.ForLanguage(LanguageCodeUnitedStates)
+	var changes []string
+	r := NewRefresher(
+		c, time.Hour, func(oldVersion, newVersion string) {
+			changes = append(changes, oldVersion+"->"+newVersion)
+		},
+	)
+	assert.Same(t, c, r.Client())
+
+	changed, err := r.Refresh()
+	require.Nil(t, err)
+	assert.False(t, changed)
+	assert.Same(t, c, r.Client())
+
+	gameVersion, err := c.VersionForGameVersion("14.4.562.1234")
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Empty(t, gameVersion)
+
+	version.Store("14.4.1")
+	changed, err = r.Refresh()
+	require.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, []string{"14.3.1->14.4.1"}, changes)
+	assert.Same(t, c, r.Client().ForVersion("14.3.1"))
+	gameVersion, err = c.VersionForGameVersion("14.4.562.1234")
+	require.Nil(t, err)
+	assert.Equal(t, "14.4.1", gameVersion)
+	assert.Equal(t, "14.4.1", r.Client().Version)
+	assert.Equal(t, languageCode(LanguageCodeUnitedStates), r.Client().Language)
+	item, err := r.Client().GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.4.1/data/en_US/item.json", item.Name)
+	item, err = c.GetItem("1001")
+	require.Nil(t, err)
+	assert.Equal(t, "/cdn/14.3.1/data/en_US/item.json", item.Name)
+
+	version.Store("")
+	changed, err = r.Refresh()
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Equal(t, api.ErrNotFound, r.LastError())
+	assert.False(t, changed)
+	assert.Equal(t, "14.4.1", r.Client().Version)
+}
+
+func TestRefresher_RefreshAfterInitError(t *testing.T) {
+	t.Parallel()
+	var version atomic.Value
+	version.Store("")
+	c := NewClient(realmDoer(&version), api.RegionEuropeWest, log.StandardLogger())
+	assert.Equal(t, api.ErrNotFound, c.InitError())
+	assert.Equal(t, fallbackVersion, c.Version)
+	r := NewRefresher(c, time.Hour, nil)
+
+	version.Store("14.3.1")
+	changed, err := r.Refresh()
+	require.Nil(t, err)
+	assert.True(t, changed)
+	assert.Nil(t, r.LastError())
+	assert.Nil(t, r.Client().InitError())
+	assert.Equal(t, "14.3.1", r.Client().Version)
+	assert.Equal(t, languageCode(LanguageCodeGermany), r.Client().Language)
+}
+
+func TestRefresher_Start(t *testing.T) {
+	t.Parallel()
+	var version atomic.Value
+	version.Store("14.3.1")
+	c := NewClient(realmDoer(&version), api.RegionEuropeWest, log.StandardLogger())
+	var mu sync.Mutex
+	var changes []string
+	r := NewRefresher(
+		c, time.Millisecond, func(oldVersion, newVersion string) {
+			mu.Lock()
+			defer mu.Unlock()
+			changes = append(changes, fmt.Sprintf("%s->%s", oldVersion, newVersion))
+		},
+	)
+	r.Start()
+	r.Start()
+	defer r.Stop()
+	version.Store("14.4.1")
+	assert.Eventually(
+		t, func() bool {
+			return r.Client().Version == "14.4.1"
+		}, time.Second, time.Millisecond,
+	)
+	r.Stop()
+	r.Stop()
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"14.3.1->14.4.1"}, changes)
+}
@@ -0,0 +1,174 @@
+package datadragon
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// TFTChampion is a unit of Teamfight Tactics
+type TFTChampion struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Tier is the cost of the unit in gold
+	Tier  int       `json:"tier"`
+	Image ImageData `json:"image"`
+}
+
+// TFTTrait is a trait of Teamfight Tactics. Data Dragon does not include the breakpoints of traits, use the
+// communitydragon package for these.
+type TFTTrait struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Image ImageData `json:"image"`
+}
+
+// TFTItem is an item of Teamfight Tactics
+type TFTItem struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Image ImageData `json:"image"`
+}
+
+// TFTAugment is an augment of Teamfight Tactics
+type TFTAugment struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Image ImageData `json:"image"`
+}
+
+// ImageURL returns the URL of the icon of the unit
+func (c *TFTChampion) ImageURL(client *Client) string {
+	return c.Image.URL(client)
+}
+
+// ImageURL returns the URL of the icon of the trait
+func (t *TFTTrait) ImageURL(client *Client) string {
+	return t.Image.URL(client)
+}
+
+// ImageURL returns the URL of the icon of the item
+func (i *TFTItem) ImageURL(client *Client) string {
+	return i.Image.URL(client)
+}
+
+// ImageURL returns the URL of the icon of the augment
+func (a *TFTAugment) ImageURL(client *Client) string {
+	return a.Image.URL(client)
+}
+
+// GetTFTChampions returns all Teamfight Tactics units of all sets included in the version
+func (c *Client) GetTFTChampions() ([]TFTChampion, error) {
+	return loadTFTData(c, &c.tftChampionsMu, &c.tftChampions, "/tft-champion.json", tftChampionID)
+}
+
+// GetTFTChampion returns the Teamfight Tactics unit with the given character ID, e.g. "TFT9_Ahri"
+func (c *Client) GetTFTChampion(id string) (TFTChampion, error) {
+	return getTFTData(c, &c.tftChampionsMu, &c.tftChampions, "/tft-champion.json", tftChampionID, id)
+}
+
+// GetTFTTraits returns all Teamfight Tactics traits of all sets included in the version
+func (c *Client) GetTFTTraits() ([]TFTTrait, error) {
+	return loadTFTData(c, &c.tftTraitsMu, &c.tftTraits, "/tft-trait.json", tftTraitID)
+}
+
+// GetTFTTrait returns the Teamfight Tactics trait with the given ID, e.g. "Set9_Sorcerer"
+func (c *Client) GetTFTTrait(id string) (TFTTrait, error) {
+	return getTFTData(c, &c.tftTraitsMu, &c.tftTraits, "/tft-trait.json", tftTraitID, id)
+}
+
+// GetTFTItems returns all Teamfight Tactics items
+func (c *Client) GetTFTItems() ([]TFTItem, error) {
+	return loadTFTData(c, &c.tftItemsMu, &c.tftItems, "/tft-item.json", tftItemID)
+}
+
+// GetTFTItem returns the Teamfight Tactics item with the given ID, e.g. "TFT_Item_BFSword"
+func (c *Client) GetTFTItem(id string) (TFTItem, error) {
+	return getTFTData(c, &c.tftItemsMu, &c.tftItems, "/tft-item.json", tftItemID, id)
+}
+
+// GetTFTAugments returns all Teamfight Tactics augments
+func (c *Client) GetTFTAugments() ([]TFTAugment, error) {
+	return loadTFTData(c, &c.tftAugmentsMu, &c.tftAugments, "/tft-augments.json", tftAugmentID)
+}
+
+// GetTFTAugment returns the Teamfight Tactics augment with the given ID, e.g. "TFT9_Augment_Commander_TeamingUp"
+func (c *Client) GetTFTAugment(id string) (TFTAugment, error) {
+	return getTFTData(c, &c.tftAugmentsMu, &c.tftAugments, "/tft-augments.json", tftAugmentID, id)
+}
+
+func tftChampionID(c *TFTChampion) *string { return &c.ID }
+
+func tftTraitID(t *TFTTrait) *string { return &t.ID }
+
+func tftItemID(i *TFTItem) *string { return &i.ID }
+
+func tftAugmentID(a *TFTAugment) *string { return &a.ID }
+
+// loadTFTData returns all values of the given Data Dragon file ordered by ID. The values are requested once and
+// stored in the cache guarded by mu. Values without ID get the key of the file as ID.
+func loadTFTData[T any](
+	c *Client, mu *sync.RWMutex, cache *map[string]T, endpoint string, id func(*T) *string,
+) ([]T, error) {
+	unlock, toggle := internal.RWLockToggle(mu)
+	defer unlock()
+	if len(*cache) < 1 {
+		toggle()
+		var res map[string]T
+		if err := c.getInto(endpoint, &res); err != nil {
+			return nil, err
+		}
+		*cache = make(map[string]T, len(res))
+		for key, value := range res {
+			if *id(&value) == "" {
+				*id(&value) = key
+			}
+			(*cache)[tftKey(*id(&value))] = value
+		}
+	}
+	return sortedTFTData(*cache, id), nil
+}
+
+// getTFTData returns the value with the given ID of the given Data Dragon file, see loadTFTData
+func getTFTData[T any](
+	c *Client, mu *sync.RWMutex, cache *map[string]T, endpoint string, id func(*T) *string, key string,
+) (T, error) {
+	if _, err := loadTFTData(c, mu, cache, endpoint, id); err != nil {
+		var zero T
+		return zero, err
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	return lookupTFTData(*cache, key)
+}
+
+// tftKey returns the cache key of a Teamfight Tactics ID. Some versions of Data Dragon use the path of the game
+// file as ID, e.g. "Maps/Shipping/Map22/Sets/TFTSet9/Shops/TFT9_Ahri", so only the last segment is used.
+func tftKey(id string) string {
+	return strings.ToLower(id[strings.LastIndex(id, "/")+1:])
+}
+
+func lookupTFTData[T any](cache map[string]T, id string) (T, error) {
+	value, ok := cache[tftKey(id)]
+	if !ok {
+		return value, api.ErrNotFound
+	}
+	return value, nil
+}
+
+// sortedTFTData returns the values of the cache ordered by ID
+func sortedTFTData[T any](cache map[string]T, id func(*T) *string) []T {
+	res := make([]T, 0, len(cache))
+	for _, value := range cache {
+		res = append(res, value)
+	}
+	sort.Slice(
+		res, func(i, j int) bool {
+			return *id(&res[i]) < *id(&res[j])
+		},
+	)
+	return res
+}
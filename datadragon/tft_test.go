@@ -0,0 +1,105 @@
+package datadragon
+
+import (
+	"net/http"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestClient_GetTFTChampions(t *testing.T) {
+	t.Parallel()
+	c := NewClient(
+		dataDragonResponseDoer(
+			map[string]TFTChampion{
+				"Maps/Shipping/Map22/Sets/TFTSet9/Shops/TFT9_Ahri": {
+					ID: "Maps/Shipping/Map22/Sets/TFTSet9/Shops/TFT9_Ahri", Name: "Ahri", Tier: 2,
+				},
+				"TFT9_Aatrox": {Name: "Aatrox", Tier: 1},
+			},
+		), api.RegionEuropeWest, log.StandardLogger(),
+	)
+	champions, err := c.GetTFTChampions()
+	require.Nil(t, err)
+	require.Len(t, champions, 2)
+	assert.Equal(t, "Ahri", champions[0].Name)
+	assert.Equal(t, "TFT9_Aatrox", champions[1].ID)
+	champion, err := c.GetTFTChampion("TFT9_Ahri")
+	require.Nil(t, err)
+	assert.Equal(t, 2, champion.Tier)
+	champion, err = c.GetTFTChampion("tft9_aatrox")
+	require.Nil(t, err)
+	assert.Equal(t, "Aatrox", champion.Name)
+	_, err = c.GetTFTChampion("TFT9_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetTFTTraits(t *testing.T) {
+	t.Parallel()
+	c := NewClient(
+		dataDragonResponseDoer(map[string]TFTTrait{"Set9_Sorcerer": {ID: "Set9_Sorcerer", Name: "Sorcerer"}}),
+		api.RegionEuropeWest, log.StandardLogger(),
+	)
+	traits, err := c.GetTFTTraits()
+	require.Nil(t, err)
+	assert.Len(t, traits, 1)
+	trait, err := c.GetTFTTrait("Set9_Sorcerer")
+	require.Nil(t, err)
+	assert.Equal(t, "Sorcerer", trait.Name)
+	_, err = c.GetTFTTrait("Set9_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetTFTItems(t *testing.T) {
+	t.Parallel()
+	c := NewClient(
+		dataDragonResponseDoer(map[string]TFTItem{"TFT_Item_BFSword": {ID: "TFT_Item_BFSword", Name: "B.F. Sword"}}),
+		api.RegionEuropeWest, log.StandardLogger(),
+	)
+	items, err := c.GetTFTItems()
+	require.Nil(t, err)
+	assert.Len(t, items, 1)
+	item, err := c.GetTFTItem("TFT_Item_BFSword")
+	require.Nil(t, err)
+	assert.Equal(t, "B.F. Sword", item.Name)
+	_, err = c.GetTFTItem("TFT_Item_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_GetTFTAugments(t *testing.T) {
+	t.Parallel()
+	c := NewClient(
+		dataDragonResponseDoer(map[string]TFTAugment{"TFT9_Augment_Test": {Name: "Test"}}),
+		api.RegionEuropeWest, log.StandardLogger(),
+	)
+	c.Version = "14.1.1"
+	augment, err := c.GetTFTAugment("TFT9_Augment_Test")
+	require.Nil(t, err)
+	assert.Equal(t, "TFT9_Augment_Test", augment.ID)
+	augment.Image = ImageData{Full: "test.png", Group: "tft-augment"}
+	assert.Equal(t, DefaultBaseURL+"/cdn/14.1.1/img/tft-augment/test.png", augment.ImageURL(c))
+	_, err = c.GetTFTAugment("TFT9_Augment_Unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	c.ClearCaches()
+	augments, err := c.GetTFTAugments()
+	require.Nil(t, err)
+	assert.Len(t, augments, 1)
+}
+
+func TestClient_GetTFTData_Error(t *testing.T) {
+	t.Parallel()
+	c := NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger())
+	_, err := c.GetTFTChampion("TFT9_Ahri")
+	assert.Equal(t, api.ErrForbidden, err)
+	_, err = c.GetTFTTrait("Set9_Sorcerer")
+	assert.Equal(t, api.ErrForbidden, err)
+	_, err = c.GetTFTItem("TFT_Item_BFSword")
+	assert.Equal(t, api.ErrForbidden, err)
+	_, err = c.GetTFTAugment("TFT9_Augment_Test")
+	assert.Equal(t, api.ErrForbidden, err)
+}
@@ -0,0 +1,133 @@
+package datadragon
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// clientKey identifies the data set of a client
+type clientKey struct {
+	version  string
+	language languageCode
+}
+
+// registry holds one client per version and language so that clients for the same data set share their caches
+type registry struct {
+	mu         sync.Mutex
+	clients    map[clientKey]*Client
+	versionsMu sync.RWMutex
+	versions   []string
+}
+
+func newRegistry() *registry {
+	return &registry{clients: map[clientKey]*Client{}}
+}
+
+// add registers the client for its version and language unless a client for these is already registered
+func (r *registry) add(c *Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := clientKey{version: c.Version, language: c.Language}
+	if _, ok := r.clients[key]; !ok {
+		r.clients[key] = c
+	}
+}
+
+// get returns the registered client for the given version and language, deriving a new one from c if none exists
+func (r *registry) get(c *Client, version string, language languageCode) *Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := clientKey{version: version, language: language}
+	if client, ok := r.clients[key]; ok {
+		return client
+	}
+	client := &Client{
+		logger:            c.logger,
+		Version:           version,
+		Language:          language,
+		client:            c.client,
+		baseURL:           c.baseURL,
+		registry:          r,
+		realm:             c.realm,
+		championsById:     map[string]ChampionDataExtended{},
+		championIDsByKey:  map[string]string{},
+		championIDsByName: map[string]string{},
+	}
+	r.clients[key] = client
+	return client
+}
+
+// resetVersions clears the cached list of versions so that it is requested again on next use
+func (r *registry) resetVersions() {
+	r.versionsMu.Lock()
+	defer r.versionsMu.Unlock()
+	r.versions = nil
+}
+
+// ForVersion returns a client serving data of the given Data Dragon version in the language of this client,
+// e.g. "14.3.1". Clients for the same version and language share their caches.
+func (c *Client) ForVersion(version string) *Client {
+	return c.registry.get(c, version, c.Language)
+}
+
+// ForLanguage returns a client serving data of the version of this client in the given language.
+// Clients for the same version and language share their caches.
+func (c *Client) ForLanguage(language languageCode) *Client {
+	return c.registry.get(c, c.Version, language)
+}
+
+// ForGameVersion returns a client serving data of the Data Dragon version matching the given game version as
+// returned by the Riot API, e.g. "14.3.562.1234"
+func (c *Client) ForGameVersion(gameVersion string) (*Client, error) {
+	version, err := c.VersionForGameVersion(gameVersion)
+	if err != nil {
+		return nil, err
+	}
+	return c.ForVersion(version), nil
+}
+
+// GetVersions returns all available Data Dragon versions, newest first
+func (c *Client) GetVersions() ([]string, error) {
+	unlock, toggle := internal.RWLockToggle(&c.registry.versionsMu)
+	defer unlock()
+	if len(c.registry.versions) < 1 {
+		toggle()
+		response, err := c.doRequest(dataDragonBaseURL, "/api/versions.json")
+		if err != nil {
+			return nil, err
+		}
+		var versions []string
+		if err := json.NewDecoder(response.Body).Decode(&versions); err != nil {
+			return nil, err
+		}
+		c.registry.versions = versions
+	}
+	res := make([]string, len(c.registry.versions))
+	copy(res, c.registry.versions)
+	return res, nil
+}
+
+// VersionForGameVersion returns the newest Data Dragon version for the patch of the given game version.
+// Game versions consist of the major and minor version of the patch followed by build numbers, e.g.
+// "14.3.562.1234" maps to "14.3.1". The "Version " prefix used by Teamfight Tactics matches is ignored.
+func (c *Client) VersionForGameVersion(gameVersion string) (string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(gameVersion, "Version "), ".", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", api.ErrNotFound
+	}
+	prefix := parts[0] + "." + parts[1] + "."
+	versions, err := c.GetVersions()
+	if err != nil {
+		return "", err
+	}
+	for _, version := range versions {
+		if strings.HasPrefix(version, prefix) {
+			return version, nil
+		}
+	}
+	return "", api.ErrNotFound
+}
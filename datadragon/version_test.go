@@ -0,0 +1,117 @@
+package datadragon
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+var testVersions = []string{"14.4.1", "14.3.1", "14.2.1", "14.1.1", "4.20.1", "lolpatch_3.7"}
+
+// recordingDoer returns a doer which serves the versions file and an empty item list for everything else and
+// records all requested URLs
+func recordingDoer() (*mock.Doer, func() []string) {
+	var mu sync.Mutex
+	var requested []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			mu.Lock()
+			requested = append(requested, r.URL.String())
+			mu.Unlock()
+			if r.URL.Path == "/api/versions.json" {
+				return mock.NewJSONMockDoer(testVersions, 200).Do(r)
+			}
+			return mock.NewJSONMockDoer(dataDragonResponse{Data: map[string]Item{"1001": {}}}, 200).Do(r)
+		},
+	}
+	return doer, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), requested...)
+	}
+}
+
+func TestClient_ForVersion(t *testing.T) {
+	t.Parallel()
+	doer, requested := recordingDoer()
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	c.Version = "14.4.1"
+	c.Language = LanguageCodeUnitedStates
+
+	korean := c.ForVersion("14.3.1").ForLanguage(LanguageCodeKorea)
+	assert.Equal(t, "14.3.1", korean.Version)
+	assert.Equal(t, languageCode(LanguageCodeKorea), korean.Language)
+	assert.Same(t, korean, c.ForLanguage(LanguageCodeKorea).ForVersion("14.3.1"))
+	assert.Same(t, c.ForVersion("14.3.1"), korean.ForLanguage(LanguageCodeUnitedStates))
+
+	for i := 0; i < 2; i++ {
+		_, err := korean.GetItems()
+		require.Nil(t, err)
+		_, err = c.GetItems()
+		require.Nil(t, err)
+	}
+	assert.Equal(
+		t, []string{
+			"https://ddragon.leagueoflegends.com/realms/euw.json",
+			"https://ddragon.leagueoflegends.com/cdn/14.3.1/data/ko_KR/item.json",
+			"https://ddragon.leagueoflegends.com/cdn/14.4.1/data/en_US/item.json",
+		}, requested(),
+	)
+}
+
+func TestClient_GetVersions(t *testing.T) {
+	t.Parallel()
+	doer, requested := recordingDoer()
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	got, err := c.GetVersions()
+	require.Nil(t, err)
+	assert.Equal(t, testVersions, got)
+	got, err = c.ForVersion("14.1.1").GetVersions()
+	require.Nil(t, err)
+	assert.Equal(t, testVersions, got)
+	assert.Len(t, requested(), 2)
+
+	c = NewClient(mock.NewStatusMockDoer(http.StatusForbidden), api.RegionEuropeWest, log.StandardLogger())
+	_, err = c.GetVersions()
+	assert.Equal(t, api.ErrForbidden, err)
+}
+
+func TestClient_VersionForGameVersion(t *testing.T) {
+	t.Parallel()
+	doer, _ := recordingDoer()
+	c := NewClient(doer, api.RegionEuropeWest, log.StandardLogger())
+	tests := []struct {
+		gameVersion string
+		want        string
+		wantErr     error
+	}{
+		{gameVersion: "14.3.562.1234", want: "14.3.1"},
+		{gameVersion: "14.1", want: "14.1.1"},
+		{gameVersion: "4.20.0.315", want: "4.20.1"},
+		{gameVersion: "Version 14.3.562.1234 (Feb 01 2024/12:00:00) [PUBLIC] <Releases/14.3>", want: "14.3.1"},
+		{gameVersion: "4.2.0.315", wantErr: api.ErrNotFound},
+		{gameVersion: "14", wantErr: api.ErrNotFound},
+		{gameVersion: "", wantErr: api.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.gameVersion, func(t *testing.T) {
+				got, err := c.VersionForGameVersion(tt.gameVersion)
+				assert.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+	client, err := c.ForGameVersion("14.2.555.1")
+	require.Nil(t, err)
+	assert.Equal(t, "14.2.1", client.Version)
+	_, err = c.ForGameVersion("1.1")
+	assert.Equal(t, api.ErrNotFound, err)
+}
@@ -0,0 +1,143 @@
+// Package fixture provides record-and-replay implementations of the Doer interface used by all golio clients.
+// A Recorder wraps a real HTTP client and captures request/response pairs into a golden file, while a Replayer
+// serves a golden file back deterministically without any network access.
+package fixture
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	apiTokenHeaderKey      = "X-Riot-Token"
+	authorizationHeaderKey = "Authorization"
+	scrubbedValue          = "SCRUBBED"
+)
+
+// Interaction is a single recorded request/response pair
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the recorded part of an HTTP request
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+// Response is the recorded part of an HTTP response
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	// Body is set if the response body is valid JSON, which is the case for all Riot API payloads
+	Body json.RawMessage `json:"body,omitempty"`
+	// RawBody is set if the response body is not valid JSON, e.g. for images
+	RawBody []byte `json:"rawBody,omitempty"`
+}
+
+// Cassette is the content of a golden file
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Load reads a cassette from the golden file at the given path
+func Load(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		return nil, err
+	}
+	return &cassette, nil
+}
+
+// Save writes the cassette to the golden file at the given path
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
+
+// Scrubber removes sensitive values from recorded interactions. The X-Riot-Token and Authorization headers, which
+// contain the API key and RSO access tokens, are always removed.
+type Scrubber struct {
+	// Replacements maps sensitive values, e.g. PUUIDs, to the placeholder they are replaced with in
+	// URLs and bodies
+	Replacements map[string]string
+}
+
+// NewPUUIDScrubber returns a scrubber replacing each of the given PUUIDs with a stable placeholder
+// of the form PUUID-1, PUUID-2, ...
+func NewPUUIDScrubber(puuids ...string) *Scrubber {
+	s := &Scrubber{Replacements: map[string]string{}}
+	for i, puuid := range puuids {
+		s.Replacements[puuid] = "PUUID-" + strconv.Itoa(i+1)
+	}
+	return s
+}
+
+// ScrubString replaces all sensitive values in the given string
+func (s *Scrubber) ScrubString(in string) string {
+	if s == nil {
+		return in
+	}
+	for _, old := range s.sortedKeys() {
+		in = strings.ReplaceAll(in, old, s.Replacements[old])
+	}
+	return in
+}
+
+// ScrubHeader returns a copy of the header with the API and access tokens and all sensitive values removed
+func (s *Scrubber) ScrubHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+	res := make(http.Header, len(header))
+	for key, values := range header {
+		if canonical := http.CanonicalHeaderKey(key); canonical == apiTokenHeaderKey ||
+			canonical == authorizationHeaderKey {
+			res[key] = []string{scrubbedValue}
+			continue
+		}
+		scrubbed := make([]string, 0, len(values))
+		for _, value := range values {
+			scrubbed = append(scrubbed, s.ScrubString(value))
+		}
+		res[key] = scrubbed
+	}
+	return res
+}
+
+// sortedKeys returns the values to replace ordered by descending length so that values which contain
+// other values are replaced first
+func (s *Scrubber) sortedKeys() []string {
+	keys := make([]string, 0, len(s.Replacements))
+	for key := range s.Replacements {
+		if key != "" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(
+		keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) > len(keys[j])
+			}
+			return keys[i] < keys[j]
+		},
+	)
+	return keys
+}
+
+func requestKey(method, url string) string {
+	return method + " " + url
+}
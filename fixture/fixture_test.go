@@ -0,0 +1,82 @@
+package fixture
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrubber_ScrubString(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		scrubber *Scrubber
+		in       string
+		want     string
+	}{
+		{
+			name:     "nil scrubber",
+			scrubber: nil,
+			in:       "/lol/match/v5/matches/by-puuid/abc/ids",
+			want:     "/lol/match/v5/matches/by-puuid/abc/ids",
+		},
+		{
+			name:     "puuids",
+			scrubber: NewPUUIDScrubber("abc", "abcdef"),
+			in:       `{"participants":["abc","abcdef"]}`,
+			want:     `{"participants":["PUUID-1","PUUID-2"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.scrubber.ScrubString(tt.in))
+			},
+		)
+	}
+}
+
+func TestScrubber_ScrubHeader(t *testing.T) {
+	t.Parallel()
+	header := http.Header{
+		"X-Riot-Token": []string{"RGAPI-secret"},
+		"Accept":       []string{"application/json"},
+	}
+	got := NewPUUIDScrubber().ScrubHeader(header)
+	assert.Equal(t, []string{scrubbedValue}, got["X-Riot-Token"])
+	assert.Equal(t, []string{"application/json"}, got["Accept"])
+	assert.Equal(t, []string{"RGAPI-secret"}, header["X-Riot-Token"])
+	assert.Nil(t, NewPUUIDScrubber().ScrubHeader(nil))
+}
+
+func TestScrubber_ScrubHeaderAuthorization(t *testing.T) {
+	t.Parallel()
+	header := http.Header{"Authorization": []string{"Bearer access-token"}}
+	var scrubber *Scrubber
+	got := scrubber.ScrubHeader(header)
+	assert.Equal(t, []string{scrubbedValue}, got["Authorization"])
+	assert.Equal(t, []string{"Bearer access-token"}, header["Authorization"])
+}
+
+func TestCassette_SaveLoad(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := &Cassette{
+		Interactions: []Interaction{
+			{
+				Request:  Request{Method: "GET", URL: "https://euw1.api.riotgames.com/lol/status/v4/platform-data"},
+				Response: Response{StatusCode: 200, Body: []byte(`{"id":"EUW1"}`)},
+			},
+		},
+	}
+	require.NoError(t, cassette.Save(path))
+	got, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, cassette.Interactions[0].Request, got.Interactions[0].Request)
+	assert.JSONEq(t, `{"id":"EUW1"}`, string(got.Interactions[0].Response.Body))
+	_, err = Load(filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package fixture
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// Recorder is a Doer which forwards all requests to a wrapped Doer and records the request/response pairs
+type Recorder struct {
+	client   internal.Doer
+	scrubber *Scrubber
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder returns a new recorder forwarding requests to the given client. If a scrubber is given, all recorded
+// URLs, headers and bodies are scrubbed with it.
+func NewRecorder(client internal.Doer, scrubber *Scrubber) *Recorder {
+	return &Recorder{
+		client:   client,
+		scrubber: scrubber,
+	}
+}
+
+// Do processes the request using the wrapped client and records the response
+func (r *Recorder) Do(request *http.Request) (*http.Response, error) {
+	response, err := r.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	var body []byte
+	if response.Body != nil {
+		body, err = io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	interaction := Interaction{
+		Request: Request{
+			Method: request.Method,
+			URL:    r.scrubber.ScrubString(request.URL.String()),
+			Header: r.scrubber.ScrubHeader(request.Header),
+		},
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Header:     r.scrubber.ScrubHeader(response.Header),
+		},
+	}
+	scrubbedBody := []byte(r.scrubber.ScrubString(string(body)))
+	if len(scrubbedBody) > 0 && json.Valid(scrubbedBody) {
+		interaction.Response.Body = scrubbedBody
+	} else if len(scrubbedBody) > 0 {
+		interaction.Response.RawBody = scrubbedBody
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return response, nil
+}
+
+// Cassette returns a copy of all interactions recorded so far
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	interactions := make([]Interaction, len(r.cassette.Interactions))
+	copy(interactions, r.cassette.Interactions)
+	return &Cassette{Interactions: interactions}
+}
+
+// Save writes all interactions recorded so far to the golden file at the given path
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
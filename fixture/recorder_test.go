@@ -0,0 +1,75 @@
+package fixture
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestRecorder_Do(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		doer        *mock.Doer
+		wantBody    string
+		wantRawBody string
+		wantErr     bool
+	}{
+		{
+			name:     "json body",
+			doer:     mock.NewJSONMockDoer(map[string]string{"puuid": "secret-puuid"}, 200),
+			wantBody: `{"puuid":"PUUID-1"}`,
+		},
+		{
+			name: "raw body",
+			doer: &mock.Doer{
+				Response: http.Response{
+					StatusCode: 200,
+					Body:       &mock.ResponseBody{Content: []byte("not json")},
+				},
+			},
+			wantRawBody: "not json",
+		},
+		{
+			name: "error",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					return nil, fmt.Errorf("error")
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				recorder := NewRecorder(tt.doer, NewPUUIDScrubber("secret-puuid"))
+				request, _ := http.NewRequest("GET", "https://euw1.api.riotgames.com/by-puuid/secret-puuid", nil)
+				request.Header.Add("X-Riot-Token", "RGAPI-secret")
+				response, err := recorder.Do(request)
+				if tt.wantErr {
+					require.Error(t, err)
+					assert.Empty(t, recorder.Cassette().Interactions)
+					return
+				}
+				require.NoError(t, err)
+				_, err = io.ReadAll(response.Body)
+				require.NoError(t, err)
+				interactions := recorder.Cassette().Interactions
+				require.Len(t, interactions, 1)
+				assert.Equal(t, "https://euw1.api.riotgames.com/by-puuid/PUUID-1", interactions[0].Request.URL)
+				assert.Equal(t, []string{scrubbedValue}, interactions[0].Request.Header["X-Riot-Token"])
+				if tt.wantBody != "" {
+					assert.JSONEq(t, tt.wantBody, string(interactions[0].Response.Body))
+				}
+				assert.Equal(t, tt.wantRawBody, string(interactions[0].Response.RawBody))
+			},
+		)
+	}
+}
@@ -0,0 +1,76 @@
+package fixture
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Replayer is a Doer which serves recorded interactions instead of sending requests.
+// Requests are matched by method and URL. If the same request was recorded multiple times the responses are
+// served in recorded order, repeating the last one once all have been served.
+type Replayer struct {
+	scrubber     *Scrubber
+	mu           sync.Mutex
+	interactions map[string][]Interaction
+	served       map[string]int
+}
+
+// NewReplayer returns a new replayer for the given cassette. If a scrubber is given, it is applied to incoming
+// request URLs before matching, so the same scrubber used for recording can be used to replay with real values.
+func NewReplayer(cassette *Cassette, scrubber *Scrubber) *Replayer {
+	r := &Replayer{
+		scrubber:     scrubber,
+		interactions: map[string][]Interaction{},
+		served:       map[string]int{},
+	}
+	for _, interaction := range cassette.Interactions {
+		key := requestKey(interaction.Request.Method, interaction.Request.URL)
+		r.interactions[key] = append(r.interactions[key], interaction)
+	}
+	return r
+}
+
+// NewReplayerFromFile returns a new replayer for the golden file at the given path
+func NewReplayerFromFile(path string, scrubber *Scrubber) (*Replayer, error) {
+	cassette, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(cassette, scrubber), nil
+}
+
+// Do returns the recorded response for the given request or an error if no matching request was recorded
+func (r *Replayer) Do(request *http.Request) (*http.Response, error) {
+	key := requestKey(request.Method, r.scrubber.ScrubString(request.URL.String()))
+	r.mu.Lock()
+	interactions, ok := r.interactions[key]
+	if !ok {
+		r.mu.Unlock()
+		return nil, fmt.Errorf("no recorded interaction for %s", key)
+	}
+	i := r.served[key]
+	if i < len(interactions)-1 {
+		r.served[key]++
+	}
+	interaction := interactions[i]
+	r.mu.Unlock()
+	body := interaction.Response.RawBody
+	if len(interaction.Response.Body) > 0 {
+		body = interaction.Response.Body
+	}
+	header := http.Header{}
+	for key, values := range interaction.Response.Header {
+		header[key] = append([]string(nil), values...)
+	}
+	statusCode := interaction.Response.StatusCode
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode: statusCode,
+		Header:     header,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+		Request:    request,
+	}, nil
+}
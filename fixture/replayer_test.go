@@ -0,0 +1,79 @@
+package fixture
+
+import (
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/lol"
+)
+
+func TestReplayer_Do(t *testing.T) {
+	t.Parallel()
+	cassette := &Cassette{
+		Interactions: []Interaction{
+			{
+				Request:  Request{Method: "GET", URL: "https://example.com/a"},
+				Response: Response{StatusCode: 200, Body: []byte(`1`)},
+			},
+			{
+				Request:  Request{Method: "GET", URL: "https://example.com/a"},
+				Response: Response{StatusCode: 200, Body: []byte(`2`)},
+			},
+			{
+				Request:  Request{Method: "GET", URL: "https://example.com/image.png"},
+				Response: Response{StatusCode: 200, RawBody: []byte("png")},
+			},
+		},
+	}
+	replayer := NewReplayer(cassette, nil)
+	for _, want := range []string{"1", "2", "2"} {
+		request, _ := http.NewRequest("GET", "https://example.com/a", nil)
+		response, err := replayer.Do(request)
+		require.NoError(t, err)
+		body, _ := io.ReadAll(response.Body)
+		assert.Equal(t, want, string(body))
+	}
+	request, _ := http.NewRequest("GET", "https://example.com/image.png", nil)
+	response, err := replayer.Do(request)
+	require.NoError(t, err)
+	body, _ := io.ReadAll(response.Body)
+	assert.Equal(t, "png", string(body))
+	request, _ = http.NewRequest("GET", "https://example.com/missing", nil)
+	_, err = replayer.Do(request)
+	assert.Error(t, err)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "match.json")
+	scrubber := NewPUUIDScrubber("real-puuid")
+	want := &lol.Match{
+		Metadata: &lol.MatchMetadata{MatchID: "EUW1_1", Participants: []string{"real-puuid"}},
+		Info:     &lol.MatchInfo{GameID: 1, Participants: []*lol.Participant{{PUUID: "real-puuid", Kills: 3}}},
+	}
+	recorder := NewRecorder(mock.NewJSONMockDoer(want, 200), scrubber)
+	client := lol.NewClient(internal.NewClient(api.RegionEuropeWest, "RGAPI-secret", recorder, log.StandardLogger()))
+	_, err := client.Match.Get("EUW1_1")
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save(path))
+
+	replayer, err := NewReplayerFromFile(path, scrubber)
+	require.NoError(t, err)
+	client = lol.NewClient(internal.NewClient(api.RegionEuropeWest, "", replayer, log.StandardLogger()))
+	got, err := client.Match.Get("EUW1_1")
+	require.NoError(t, err)
+	assert.Equal(t, "PUUID-1", got.Info.Participants[0].PUUID)
+	assert.Equal(t, 3, got.Info.Participants[0].Kills)
+
+	_, err = NewReplayerFromFile(filepath.Join(t.TempDir(), "missing.json"), nil)
+	assert.Error(t, err)
+}
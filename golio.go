@@ -27,6 +27,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/apikey"
 	"github.com/KnutZuidema/golio/datadragon"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/riot"
@@ -39,9 +40,13 @@ type Client struct {
 	DataDragon *datadragon.Client
 	Static     *static.Client
 	client     internal.Doer
+	transport  http.RoundTripper
 	logger     log.FieldLogger
 	region     api.Region
 	apiKey     string
+	riotOpts   []riot.Option
+	ddOpts     []datadragon.Option
+	staticOpts []static.Option
 }
 
 // Option is used to alter the attributes of a client
@@ -68,6 +73,51 @@ func WithRegion(r api.Region) Option {
 	}
 }
 
+// WithTransport sets the given round tripper as transport of the http client used by the golio client. The
+// transport is set on a copy of the http client set with WithClient, keeping its other settings, independent of
+// the order of the options. It has no effect if the client set with WithClient is not an *http.Client.
+func WithTransport(t http.RoundTripper) Option {
+	return func(client *Client) {
+		client.transport = t
+	}
+}
+
+// WithRiotAPIBaseURL sets the base URL template for the Riot API, e.g. "http://proxy.local/riot/{region}".
+// The placeholder {region} is replaced with the region or route of each request.
+func WithRiotAPIBaseURL(baseURL string) Option {
+	return func(client *Client) {
+		client.riotOpts = append(client.riotOpts, riot.WithBaseURL(baseURL))
+	}
+}
+
+// WithDataDragonBaseURL sets the base URL for the Data Dragon service, e.g. "http://proxy.local/ddragon"
+func WithDataDragonBaseURL(baseURL string) Option {
+	return func(client *Client) {
+		client.ddOpts = append(client.ddOpts, datadragon.WithBaseURL(baseURL))
+	}
+}
+
+// WithStaticBaseURL sets the base URL for Riot's static developer data, e.g. "http://proxy.local/static"
+func WithStaticBaseURL(baseURL string) Option {
+	return func(client *Client) {
+		client.staticOpts = append(client.staticOpts, static.WithBaseURL(baseURL))
+	}
+}
+
+// WithKeyProvider sets the provider used to get the API key for each request to the Riot API, e.g. to refresh
+// expiring development keys at runtime. The API key passed to NewClient is ignored.
+func WithKeyProvider(provider apikey.Provider) Option {
+	return func(client *Client) {
+		client.riotOpts = append(client.riotOpts, riot.WithKeyProvider(provider))
+	}
+}
+
+// WithAPIKeys spreads requests to the Riot API across the given keys, each with its own rate limit. Keys rejected
+// by the API are no longer used. The API key passed to NewClient is ignored.
+func WithAPIKeys(keys ...string) Option {
+	return WithKeyProvider(apikey.NewPool(keys...))
+}
+
 // NewClient returns a new client for both the Riot API and the Data Dragon service
 func NewClient(apiKey string, options ...Option) *Client {
 	c := &Client{
@@ -79,8 +129,24 @@ func NewClient(apiKey string, options ...Option) *Client {
 	for _, opt := range options {
 		opt(c)
 	}
-	c.Riot = riot.NewClient(c.region, c.apiKey, c.client, c.logger)
-	c.DataDragon = datadragon.NewClient(c.client, c.region, c.logger)
-	c.Static = static.NewClient(c.client, c.logger)
+	c.applyTransport()
+	c.Riot = riot.NewClient(c.region, c.apiKey, c.client, c.logger, c.riotOpts...)
+	c.DataDragon = datadragon.NewClient(c.client, c.region, c.logger, c.ddOpts...)
+	c.Static = static.NewClient(c.client, c.logger, c.staticOpts...)
 	return c
 }
+
+// applyTransport sets the transport on a copy of the http client, so that shared clients are not modified
+func (c *Client) applyTransport() {
+	if c.transport == nil {
+		return
+	}
+	httpClient, ok := c.client.(*http.Client)
+	if !ok {
+		c.logger.Warn("transport ignored, client is not an *http.Client")
+		return
+	}
+	clone := *httpClient
+	clone.Transport = c.transport
+	c.client = &clone
+}
@@ -1,10 +1,17 @@
 package golio
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
@@ -19,3 +26,76 @@ func TestNewClient(t *testing.T) {
 	)
 	require.NotNil(t, client)
 }
+
+func TestNewClient_BaseURLs(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				paths = append(paths, r.URL.Path)
+				mu.Unlock()
+				_ = json.NewEncoder(w).Encode(map[string]any{"v": "1.1.1", "l": "en_US"})
+			},
+		),
+	)
+	defer server.Close()
+	client := NewClient(
+		"api_key",
+		WithTransport(http.DefaultTransport),
+		WithRiotAPIBaseURL(server.URL+"/riot/{region}"),
+		WithDataDragonBaseURL(server.URL+"/ddragon"),
+		WithStaticBaseURL(server.URL+"/static"),
+	)
+	_, _ = client.Riot.LoL.Status.Get()
+	_, _ = client.Static.GetSeasons()
+	assert.Equal(t, "1.1.1", client.DataDragon.Version)
+	assert.Equal(
+		t, []string{
+			"/ddragon/realms/euw.json",
+			"/riot/euw1/lol/status/v4/platform-data",
+			"/static/docs/lol/seasons.json",
+		}, paths,
+	)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestWithTransport(t *testing.T) {
+	transport := roundTripperFunc(
+		func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"v":"1.1.1","l":"en_US"}`)),
+				Request:    r,
+			}, nil
+		},
+	)
+	httpClient := &http.Client{Timeout: time.Minute}
+	tests := []struct {
+		name    string
+		options []Option
+	}{
+		{name: "client first", options: []Option{WithClient(httpClient), WithTransport(transport)}},
+		{name: "transport first", options: []Option{WithTransport(transport), WithClient(httpClient)}},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := NewClient("api_key", tt.options...)
+				got, ok := client.client.(*http.Client)
+				require.True(t, ok)
+				assert.Equal(t, time.Minute, got.Timeout)
+				assert.NotNil(t, got.Transport)
+				assert.NotSame(t, httpClient, got)
+				assert.Nil(t, httpClient.Transport)
+				assert.Equal(t, "1.1.1", client.DataDragon.Version)
+			},
+		)
+	}
+}
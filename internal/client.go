@@ -3,24 +3,30 @@ package internal
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/apikey"
 )
 
 const (
-	apiURLFormat      = "%s://%s.%s%s"
-	baseURL           = "api.riotgames.com"
-	scheme            = "https"
+	// DefaultBaseURL is the default base URL template for the Riot API. The RegionPlaceholder is replaced with the
+	// region or route a request is sent to.
+	DefaultBaseURL = "https://" + RegionPlaceholder + ".api.riotgames.com"
+	// RegionPlaceholder is the placeholder in a base URL template which is replaced with the region or route
+	RegionPlaceholder = "{region}"
 	apiTokenHeaderKey = "X-Riot-Token"
 	logFieldMethod    = "method"
 	logFieldEndpoint  = "endpoint"
+	// maxKeyRetries is the number of times a request is retried with another key after its key was rejected or
+	// rate limited
+	maxKeyRetries = 3
 )
 
 // Client provides methods for communication with the Riot API.
@@ -29,6 +35,12 @@ type Client struct {
 	Region api.Region
 	APIKey string
 	Client Doer
+	// BaseURL is the template used to build request URLs. Defaults to DefaultBaseURL if empty.
+	BaseURL string
+	// Keys provides the API key for each request. APIKey is used if Keys is nil.
+	Keys apikey.Provider
+	// routeErr is the error of ForGame, which is returned by all requests of the client
+	routeErr error
 }
 
 // NewClient returns a new client.
@@ -123,6 +135,22 @@ func (c *Client) Post(endpoint string, body any, reqOptions ...RequestOption) (*
 // Rate-Limiting and retrying is handled via the corresponding response headers.
 func (c *Client) DoRequest(
 	method, endpoint string, body io.Reader, reqOptions []RequestOption,
+) (*http.Response, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = io.ReadAll(body); err != nil {
+			c.Logger().WithFields(log.Fields{logFieldMethod: "DoRequest", logFieldEndpoint: endpoint}).Debug(err)
+			return nil, err
+		}
+	}
+	return c.doRequest(method, endpoint, data, reqOptions, 0)
+}
+
+// doRequest sends the request with the given body, which is sent again for each retry. keyRetries is the number
+// of times the request was already retried with another key.
+func (c *Client) doRequest(
+	method, endpoint string, data []byte, reqOptions []RequestOption, keyRetries int,
 ) (*http.Response, error) {
 	logger := c.Logger().WithFields(
 		log.Fields{
@@ -130,35 +158,43 @@ func (c *Client) DoRequest(
 			logFieldEndpoint: endpoint,
 		},
 	)
-	request, err := c.NewRequest(method, endpoint, body, reqOptions...)
-	if err != nil {
-		logger.Debug(err)
-		return nil, err
-	}
-	response, err := c.Client.Do(request)
+	request, response, err := c.send(method, endpoint, data, reqOptions)
 	if err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
 	if response.StatusCode == http.StatusServiceUnavailable {
 		logger.Info("service unavailable, retrying")
+		closeBody(response)
 		time.Sleep(time.Second)
-		response, err = c.Client.Do(request)
+		request, response, err = c.send(method, endpoint, data, reqOptions)
 		if err != nil {
 			logger.Debug(err)
 			return nil, err
 		}
 	}
+	key := request.Header.Get(apiTokenHeaderKey)
 	if response.StatusCode == http.StatusTooManyRequests {
+		closeBody(response)
 		retry := response.Header.Get("Retry-After")
 		seconds, err := strconv.Atoi(retry)
 		if err != nil {
 			logger.Debug(err)
 			return nil, err
 		}
+		if c.report(key, response.StatusCode, time.Duration(seconds)*time.Second) && keyRetries < maxKeyRetries {
+			logger.Info("rate limited, retrying with another key")
+			return c.doRequest(method, endpoint, data, reqOptions, keyRetries+1)
+		}
 		logger.Infof("rate limited, waiting %d seconds", seconds)
 		time.Sleep(time.Duration(seconds) * time.Second)
-		return c.DoRequest(method, endpoint, body, reqOptions)
+		return c.doRequest(method, endpoint, data, reqOptions, keyRetries)
+	}
+	if (response.StatusCode == http.StatusUnauthorized || response.StatusCode == http.StatusForbidden) &&
+		c.report(key, response.StatusCode, 0) && keyRetries < maxKeyRetries {
+		logger.Infof("key rejected with status %d, retrying with another key", response.StatusCode)
+		closeBody(response)
+		return c.doRequest(method, endpoint, data, reqOptions, keyRetries+1)
 	}
 	if response.StatusCode < 200 || response.StatusCode > 299 {
 		logger.Debugf("error response: %v", response.Status)
@@ -174,6 +210,32 @@ func (c *Client) DoRequest(
 	return response, nil
 }
 
+// closeBody closes the body of a response which is discarded for a retry
+func closeBody(response *http.Response) {
+	if response.Body != nil {
+		_ = response.Body.Close()
+	}
+}
+
+// send builds a new request with a fresh reader of the given body and sends it
+func (c *Client) send(
+	method, endpoint string, data []byte, reqOptions []RequestOption,
+) (*http.Request, *http.Response, error) {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+	request, err := c.NewRequest(method, endpoint, body, reqOptions...)
+	if err != nil {
+		return nil, nil, err
+	}
+	response, err := c.Client.Do(request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return request, response, nil
+}
+
 // NewRequest returns a new http.Request with necessary headers et.
 func (c *Client) NewRequest(
 	method, endpoint string, body io.Reader, reqOptions ...RequestOption,
@@ -184,12 +246,21 @@ func (c *Client) NewRequest(
 			logFieldEndpoint: endpoint,
 		},
 	)
-	request, err := http.NewRequest(method, fmt.Sprintf(apiURLFormat, scheme, c.Region, baseURL, endpoint), body)
+	if c.routeErr != nil {
+		logger.Debug(c.routeErr)
+		return nil, c.routeErr
+	}
+	request, err := http.NewRequest(method, c.baseURL()+endpoint, body)
 	if err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
-	request.Header.Add(apiTokenHeaderKey, c.APIKey)
+	key, err := c.key()
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	request.Header.Add(apiTokenHeaderKey, key)
 	request.Header.Add("Accept", "application/json")
 	for _, opt := range reqOptions {
 		opt(request)
@@ -197,6 +268,43 @@ func (c *Client) NewRequest(
 	return request, nil
 }
 
+func (c *Client) key() (string, error) {
+	if c.Keys == nil {
+		return c.APIKey, nil
+	}
+	return c.Keys.Key()
+}
+
+// report informs the key provider about a failed request and returns true if it should be retried immediately
+func (c *Client) report(key string, statusCode int, retryAfter time.Duration) bool {
+	reporter, ok := c.Keys.(apikey.Reporter)
+	if !ok {
+		return false
+	}
+	return reporter.Report(key, statusCode, retryAfter)
+}
+
+func (c *Client) baseURL() string {
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	return strings.TrimSuffix(strings.ReplaceAll(base, RegionPlaceholder, string(c.Region)), "/")
+}
+
+// ForGame returns a copy of the client sending requests to the host serving the endpoint family of the game in
+// the region of the client. If the region can not be used for the game, all requests of the copy fail with the
+// error of api.Host instead of being sent to the wrong host.
+func (c *Client) ForGame(game api.Game, family api.EndpointFamily) *Client {
+	res := *c
+	host, err := api.Host(game, family, c.Region)
+	res.routeErr = err
+	if err == nil {
+		res.Region = host
+	}
+	return &res
+}
+
 // Logger returns a logger with client specific fields set.
 func (c *Client) Logger() log.FieldLogger {
 	return c.L.WithField("region", c.Region)
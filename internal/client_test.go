@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/apikey"
 	"github.com/KnutZuidema/golio/internal/mock"
 )
 
@@ -258,3 +262,142 @@ func failOnSecondDoer() Doer {
 		},
 	}
 }
+
+func TestClient_NewRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		region  api.Region
+		baseURL string
+		want    string
+	}{
+		{
+			name:   "default base url",
+			region: api.RegionEuropeWest,
+			want:   "https://euw1.api.riotgames.com/endpoint",
+		},
+		{
+			name:    "custom base url",
+			region:  api.Region(api.RouteEurope),
+			baseURL: "http://localhost:8080/riot/{region}/",
+			want:    "http://localhost:8080/riot/europe/endpoint",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(tt.region, "API_KEY", mock.NewStatusMockDoer(200), logrus.StandardLogger())
+				c.BaseURL = tt.baseURL
+				got, err := c.NewRequest("GET", "/endpoint", nil)
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, got.URL.String())
+				assert.Equal(t, "API_KEY", got.Header.Get(apiTokenHeaderKey))
+			},
+		)
+	}
+}
+
+func TestClient_DoRequestKeyProvider(t *testing.T) {
+	t.Parallel()
+	var usedKeys []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			key := r.Header.Get(apiTokenHeaderKey)
+			usedKeys = append(usedKeys, key)
+			switch key {
+			case "expired":
+				return mock.NewStatusMockDoer(http.StatusUnauthorized).Do(r)
+			case "limited":
+				return mock.NewHeaderMockDoer(
+					http.StatusTooManyRequests, http.Header{"Retry-After": []string{"100"}},
+				).Do(r)
+			}
+			return mock.NewJSONMockDoer(1, 200).Do(r)
+		},
+	}
+	c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+	pool := apikey.NewPool("expired", "limited", "valid")
+	c.Keys = pool
+	_, err := c.DoRequest("GET", "/endpoint", nil, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"expired", "limited", "valid"}, usedKeys)
+	assert.Equal(t, []string{"expired"}, pool.Benched())
+
+	c.Keys = apikey.NewPool("expired")
+	_, err = c.DoRequest("GET", "/endpoint", nil, nil)
+	assert.Equal(t, api.ErrUnauthorized, err)
+	_, err = c.DoRequest("GET", "/endpoint", nil, nil)
+	assert.Equal(t, apikey.ErrNoKeyAvailable, err)
+}
+
+func TestClient_DoRequestRetryBody(t *testing.T) {
+	t.Parallel()
+	var bodies []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+			if len(bodies) == 1 {
+				return mock.NewStatusMockDoer(http.StatusServiceUnavailable).Do(r)
+			}
+			if r.Header.Get(apiTokenHeaderKey) == "expired" {
+				return mock.NewStatusMockDoer(http.StatusForbidden).Do(r)
+			}
+			return mock.NewJSONMockDoer(1, 200).Do(r)
+		},
+	}
+	c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+	c.Keys = apikey.NewPool("valid", "expired")
+	_, err := c.DoRequest("POST", "/endpoint", strings.NewReader("body"), nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"body", "body", "body"}, bodies)
+}
+
+func TestClient_DoRequestMaxKeyRetries(t *testing.T) {
+	t.Parallel()
+	requests := 0
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests++
+			return mock.NewStatusMockDoer(http.StatusUnauthorized).Do(r)
+		},
+	}
+	c := NewClient(api.RegionEuropeWest, "", doer, logrus.StandardLogger())
+	fetched := 0
+	c.Keys = apikey.NewRefreshing(
+		func() (string, error) {
+			fetched++
+			return strconv.Itoa(fetched), nil
+		}, 0,
+	)
+	_, err := c.DoRequest("GET", "/endpoint", nil, nil)
+	assert.Equal(t, api.ErrUnauthorized, err)
+	assert.Equal(t, maxKeyRetries+1, requests)
+}
+
+func TestClient_ForGame(t *testing.T) {
+	t.Parallel()
+	c := NewClient(api.RegionOceania, "API_KEY", mock.NewStatusMockDoer(200), logrus.StandardLogger())
+	assert.Equal(t, api.Region(api.RouteSEA), c.ForGame(api.GameLoL, api.FamilyRegional).Region)
+	assert.Equal(t, api.Region(api.RouteAsia), c.ForGame(api.GameAccount, api.FamilyRegional).Region)
+	assert.Equal(t, api.ValShardAsiaPacific, c.ForGame(api.GameVAL, api.FamilyPlatform).Region)
+	assert.Equal(t, api.RegionOceania, c.Region)
+
+	var hosts []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			hosts = append(hosts, r.URL.Host)
+			return mock.NewStatusMockDoer(200).Do(r)
+		},
+	}
+	shard := NewClient(api.ValShardEurope, "API_KEY", doer, logrus.StandardLogger())
+	routed := shard.ForGame(api.GameLoL, api.FamilyRegional)
+	assert.Equal(t, api.ValShardEurope, routed.Region)
+	_, err := routed.Get("/lol/match/v5/matches/0")
+	assert.ErrorIs(t, err, api.ErrUnknownRegion)
+	assert.Empty(t, hosts)
+	_, err = routed.ForGame(api.GameVAL, api.FamilyPlatform).Get("/val/status/v1/platform-data")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"eu.api.riotgames.com"}, hosts)
+}
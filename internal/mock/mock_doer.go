@@ -63,6 +63,20 @@ func NewPathJSONMockDoer(pathResponses []PathJSONResponse) *Doer {
 	}
 }
 
+// NewDataDragonMockDoer constructs a new MockDoer returning the given object as data of a Data Dragon response
+func NewDataDragonMockDoer(object any) *Doer {
+	return NewJSONMockDoer(
+		struct {
+			Type    string
+			Format  string
+			Version string
+			Data    any
+		}{
+			Data: object,
+		}, 200,
+	)
+}
+
 // NewStatusMockDoer constructs a new MockDoer with the given status code
 func NewStatusMockDoer(code int) *Doer {
 	return &Doer{
@@ -55,6 +55,18 @@ func ExampleNewJSONMockDoer() {
 	// Output: status code: 200, body: {Attr:2}
 }
 
+func ExampleNewDataDragonMockDoer() {
+	doer := NewDataDragonMockDoer(map[string]string{"1": "champion"})
+	request, _ := http.NewRequest("GET", "https://example.com", nil)
+	response, _ := doer.Do(request)
+	var output struct {
+		Data map[string]string
+	}
+	_ = json.NewDecoder(response.Body).Decode(&output)
+	fmt.Printf("status code: %d, data: %v\n", response.StatusCode, output.Data)
+	// Output: status code: 200, data: map[1:champion]
+}
+
 func TestNewStatusMockDoer(t *testing.T) {
 	type args struct {
 		code int
@@ -13,8 +13,7 @@ import (
 func (ac *Client) GetByPUUID(puuid string) (*Account, error) {
 	logger := ac.logger().WithField("method", "GetByPUUID")
 	var account Account
-	c := *ac.c
-	c.Region = api.Region(api.RegionToRoute[c.Region])
+	c := ac.c.ForGame(api.GameAccount, api.FamilyRegional)
 
 	if err := c.GetInto(
 		fmt.Sprintf(endpointGetByPUUID, puuid),
@@ -30,8 +29,7 @@ func (ac *Client) GetByPUUID(puuid string) (*Account, error) {
 func (ac *Client) GetByRiotID(gameName, tagLine string) (*Account, error) {
 	logger := ac.logger().WithField("method", "GetByRiotID")
 	var account Account
-	c := *ac.c
-	c.Region = api.Region(api.RegionToRoute[c.Region])
+	c := ac.c.ForGame(api.GameAccount, api.FamilyRegional)
 
 	if err := c.GetInto(
 		fmt.Sprintf(endpointGetByRiotID, gameName, tagLine),
@@ -47,8 +45,7 @@ func (ac *Client) GetByRiotID(gameName, tagLine string) (*Account, error) {
 func (ac *Client) GetMe(accessToken string) (*Account, error) {
 	logger := ac.logger().WithField("method", "GetMe")
 	var account Account
-	c := *ac.c
-	c.Region = api.Region(api.RegionToRoute[c.Region])
+	c := ac.c.ForGame(api.GameAccount, api.FamilyRegional)
 
 	if err := c.GetInto(
 		endpointGetMe,
@@ -65,8 +62,7 @@ func (ac *Client) GetMe(accessToken string) (*Account, error) {
 func (ac *Client) GetActiveShard(game, puuid string) (*ActiveShard, error) {
 	logger := ac.logger().WithField("method", "GetActiveShard")
 	var activeShard ActiveShard
-	c := *ac.c
-	c.Region = api.Region(api.RegionToRoute[c.Region])
+	c := ac.c.ForGame(api.GameAccount, api.FamilyRegional)
 
 	if err := c.GetInto(
 		fmt.Sprintf(endpointActiveShards, game, puuid),
@@ -9,3 +9,9 @@ const (
 	endpointGetMe        = endpointAccountsBase + "/me"
 	endpointActiveShards = endpointAccountBase + "/active-shards/by-game/%s/by-puuid/%s"
 )
+
+// Games which can be passed to Client.GetActiveShard
+const (
+	GameLoR      = "lor"
+	GameValorant = "val"
+)
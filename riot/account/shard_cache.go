@@ -0,0 +1,61 @@
+package account
+
+import (
+	"sync"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// DefaultShardTTL is the time an active shard is cached by a shard cache created by NewClient of the riot package
+const DefaultShardTTL = time.Hour
+
+type shardEntry struct {
+	shard   api.Region
+	expires time.Time
+}
+
+// ShardCache looks up the active shards of players using GetActiveShard and caches the results
+type ShardCache struct {
+	client  *Client
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[string]shardEntry
+	now     func() time.Time
+}
+
+// NewShardCache returns a new cache using the given account client which caches shards for the given duration
+func NewShardCache(client *Client, ttl time.Duration) *ShardCache {
+	return &ShardCache{
+		client:  client,
+		ttl:     ttl,
+		entries: map[string]shardEntry{},
+		now:     time.Now,
+	}
+}
+
+// ActiveShard returns the active shard of the player with the given PUUID in the given game, e.g. GameValorant
+func (sc *ShardCache) ActiveShard(game, puuid string) (api.Region, error) {
+	key := game + "/" + puuid
+	sc.mu.RLock()
+	entry, ok := sc.entries[key]
+	sc.mu.RUnlock()
+	if ok && sc.now().Before(entry.expires) {
+		return entry.shard, nil
+	}
+	shard, err := sc.client.GetActiveShard(game, puuid)
+	if err != nil {
+		return "", err
+	}
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.entries[key] = shardEntry{shard: api.Region(shard.ActiveShard), expires: sc.now().Add(sc.ttl)}
+	return api.Region(shard.ActiveShard), nil
+}
+
+// Forget removes the cached shard of the player, e.g. after the player moved to another shard
+func (sc *ShardCache) Forget(game, puuid string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	delete(sc.entries, game+"/"+puuid)
+}
@@ -0,0 +1,60 @@
+package account
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestShardCache_ActiveShard(t *testing.T) {
+	t.Parallel()
+	requests := 0
+	shard := "eu"
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests++
+			if shard == "" {
+				return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+			}
+			return mock.NewJSONMockDoer(ActiveShard{Puuid: "puuid", Game: GameValorant, ActiveShard: shard}, 200).Do(r)
+		},
+	}
+	client := NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+	now := time.Now()
+	cache := NewShardCache(client, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	got, err := cache.ActiveShard(GameValorant, "puuid")
+	require.NoError(t, err)
+	assert.Equal(t, api.Region("eu"), got)
+	shard = "na"
+	got, err = cache.ActiveShard(GameValorant, "puuid")
+	require.NoError(t, err)
+	assert.Equal(t, api.Region("eu"), got)
+	assert.Equal(t, 1, requests)
+
+	// games are cached separately
+	got, err = cache.ActiveShard(GameLoR, "puuid")
+	require.NoError(t, err)
+	assert.Equal(t, api.Region("na"), got)
+	assert.Equal(t, 2, requests)
+
+	cache.Forget(GameValorant, "puuid")
+	got, err = cache.ActiveShard(GameValorant, "puuid")
+	require.NoError(t, err)
+	assert.Equal(t, api.Region("na"), got)
+
+	shard = ""
+	now = now.Add(2 * time.Minute)
+	_, err = cache.ActiveShard(GameValorant, "puuid")
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Equal(t, 4, requests)
+}
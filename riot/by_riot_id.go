@@ -0,0 +1,89 @@
+package riot
+
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/riot/account"
+	"github.com/KnutZuidema/golio/riot/lol"
+	"github.com/KnutZuidema/golio/riot/lor"
+	"github.com/KnutZuidema/golio/riot/tft"
+	"github.com/KnutZuidema/golio/riot/val"
+)
+
+// GetLoLMatchesByRiotID returns the IDs of the League of Legends matches played by the given Riot ID
+func (c *Client) GetLoLMatchesByRiotID(
+	riotID string, start, count int, options ...*lol.MatchListOptions,
+) ([]string, error) {
+	puuid, err := c.Resolver.PUUID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	return c.LoL.Match.List(puuid, start, count, options...)
+}
+
+// GetLoLRankByRiotID returns the League of Legends league entries of the given Riot ID
+func (c *Client) GetLoLRankByRiotID(riotID string) ([]*lol.LeagueItem, error) {
+	puuid, err := c.Resolver.PUUID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	return c.LoL.League.ListByPuuid(puuid)
+}
+
+// GetTFTMatchesByRiotID returns the IDs of the TFT matches played by the given Riot ID
+func (c *Client) GetTFTMatchesByRiotID(riotID string) ([]string, error) {
+	puuid, err := c.Resolver.PUUID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	return c.TFT.Match.GetMatchesByPUUID(puuid)
+}
+
+// GetTFTRankByRiotID returns the TFT league entries of the given Riot ID
+func (c *Client) GetTFTRankByRiotID(riotID string) ([]*tft.LeagueEntry, error) {
+	puuid, err := c.Resolver.PUUID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	return c.TFT.League.GetEntriesByPUUID(puuid)
+}
+
+// GetValMatchListByRiotID returns the VALORANT match history of the given Riot ID, requested from the
+// player's active shard
+func (c *Client) GetValMatchListByRiotID(riotID string) (*val.MatchList, error) {
+	client, puuid, err := c.ValForRiotID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	return client.Match.GetMatchListByPUUID(puuid)
+}
+
+// ValForRiotID returns a VALORANT client for the active shard of the given Riot ID together with their PUUID
+func (c *Client) ValForRiotID(riotID string) (*val.Client, string, error) {
+	puuid, shard, err := c.activeShard(account.GameValorant, riotID)
+	if err != nil {
+		return nil, "", err
+	}
+	return val.NewClient(c.baseFor(shard)), puuid, nil
+}
+
+// LoRForRiotID returns a Legends of Runeterra client for the active shard of the given Riot ID together with
+// their PUUID
+func (c *Client) LoRForRiotID(riotID string) (*lor.Client, string, error) {
+	puuid, shard, err := c.activeShard(account.GameLoR, riotID)
+	if err != nil {
+		return nil, "", err
+	}
+	return lor.NewClient(c.baseFor(shard)), puuid, nil
+}
+
+func (c *Client) activeShard(game, riotID string) (string, api.Region, error) {
+	puuid, err := c.Resolver.PUUID(riotID)
+	if err != nil {
+		return "", "", err
+	}
+	shard, err := c.Shards.ActiveShard(game, puuid)
+	if err != nil {
+		return "", "", err
+	}
+	return puuid, shard, nil
+}
@@ -0,0 +1,109 @@
+package riot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/riot/account"
+)
+
+func TestClient_ByRiotID(t *testing.T) {
+	t.Parallel()
+	faker := account.Account{Puuid: "puuid", GameName: "Faker", TagLine: "KR1"}
+	tests := []struct {
+		name string
+		call func(c *Client) error
+		want []string
+	}{
+		{
+			name: "lol matches",
+			call: func(c *Client) error {
+				_, err := c.GetLoLMatchesByRiotID("Faker#KR1", 0, 20)
+				return err
+			},
+			want: []string{"/europe/lol/match/v5/matches/by-puuid/puuid/ids"},
+		},
+		{
+			name: "lol rank",
+			call: func(c *Client) error {
+				_, err := c.GetLoLRankByRiotID("Faker#KR1")
+				return err
+			},
+			want: []string{"/euw1/lol/league/v4/entries/by-puuid/puuid"},
+		},
+		{
+			name: "tft matches",
+			call: func(c *Client) error {
+				_, err := c.GetTFTMatchesByRiotID("Faker#KR1")
+				return err
+			},
+			want: []string{"/europe/tft/match/v1/matches/by-puuid/puuid/ids"},
+		},
+		{
+			name: "tft rank",
+			call: func(c *Client) error {
+				_, err := c.GetTFTRankByRiotID("Faker#KR1")
+				return err
+			},
+			want: []string{"/euw1/tft/league/v1/by-puuid/puuid"},
+		},
+		{
+			name: "val match list",
+			call: func(c *Client) error {
+				_, err := c.GetValMatchListByRiotID("Faker#KR1")
+				return err
+			},
+			want: []string{
+				"/europe/riot/account/v1/active-shards/by-game/val/by-puuid/puuid",
+				"/eu/val/match/v1/matchlists/by-puuid/puuid",
+			},
+		},
+		{
+			name: "lor client",
+			call: func(c *Client) error {
+				client, puuid, err := c.LoRForRiotID("Faker#KR1")
+				require.NoError(t, err)
+				assert.Equal(t, "puuid", puuid)
+				assert.NotNil(t, client.Ranked)
+				return err
+			},
+			want: []string{"/europe/riot/account/v1/active-shards/by-game/lor/by-puuid/puuid"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				server := newFakeServer(t, "eu", faker)
+				c := server.client()
+				require.NoError(t, tt.call(c))
+				want := append([]string{"/europe/riot/account/v1/accounts/by-riot-id/Faker/KR1"}, tt.want...)
+				assert.Equal(t, want, server.Requests())
+				assert.Equal(t, api.RegionEuropeWest, c.base.Region)
+			},
+		)
+	}
+}
+
+func TestClient_ByRiotIDInvalid(t *testing.T) {
+	t.Parallel()
+	server := newFakeServer(t, "")
+	c := server.client()
+	_, err := c.GetLoLMatchesByRiotID("invalid", 0, 20)
+	assert.Equal(t, ErrInvalidRiotID, err)
+	_, err = c.GetLoLRankByRiotID("Unknown#1")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetTFTMatchesByRiotID("Unknown#1")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetTFTRankByRiotID("Unknown#1")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = c.GetValMatchListByRiotID("Unknown#1")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, _, err = c.LoRForRiotID("Unknown#1")
+	assert.Equal(t, api.ErrNotFound, err)
+	for _, path := range server.Requests() {
+		assert.Equal(t, "/europe/riot/account/v1/accounts/by-riot-id/Unknown/1", path)
+	}
+}
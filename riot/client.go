@@ -6,6 +6,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/apikey"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/riot/account"
 	"github.com/KnutZuidema/golio/riot/lol"
@@ -40,18 +41,51 @@ type Client struct {
 	LoR     *lor.Client
 	Val     *val.Client
 	TFT     *tft.Client
+
+	// Resolver resolves Riot IDs for the ...ByRiotID methods
+	Resolver *Resolver
+	// Shards caches the active shards of players, e.g. for Val.WithActiveShards
+	Shards *account.ShardCache
+
+	base *internal.Client
+}
+
+// Option is used to alter the base client shared by all Riot API clients
+type Option func(*internal.Client)
+
+// WithBaseURL sets the base URL template used for all requests to the Riot API, e.g.
+// "http://proxy.local/{region}". The placeholder {region} is replaced with the region or route of a request.
+func WithBaseURL(baseURL string) Option {
+	return func(c *internal.Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithKeyProvider sets the provider used to get the API key for each request instead of a static key
+func WithKeyProvider(provider apikey.Provider) Option {
+	return func(c *internal.Client) {
+		c.Keys = provider
+	}
 }
 
 // NewClient returns a new api client for the Riot API
-func NewClient(region api.Region, apiKey string, client internal.Doer, logger log.FieldLogger) *Client {
+func NewClient(
+	region api.Region, apiKey string, client internal.Doer, logger log.FieldLogger, options ...Option,
+) *Client {
 	baseClient := internal.NewClient(region, apiKey, client, logger)
+	for _, opt := range options {
+		opt(baseClient)
+	}
 	c := &Client{
 		Account: account.NewClient(baseClient),
 		LoL:     lol.NewClient(baseClient),
 		LoR:     lor.NewClient(baseClient),
 		Val:     val.NewClient(baseClient),
 		TFT:     tft.NewClient(baseClient),
+		base:    baseClient,
 	}
+	c.Resolver = NewResolver(c.Account, DefaultRiotIDTTL)
+	c.Shards = account.NewShardCache(c.Account, account.DefaultShardTTL)
 
 	// TODO: deprecated, remove in a future release
 	c.ChampionMastery = c.LoL.ChampionMastery
@@ -65,3 +99,10 @@ func NewClient(region api.Region, apiKey string, client internal.Doer, logger lo
 	c.ThirdPartyCode = c.LoL.ThirdPartyCode
 	return c
 }
+
+// baseFor returns a copy of the base client sending requests to the given region
+func (c *Client) baseFor(region api.Region) *internal.Client {
+	base := *c.base
+	base.Region = region
+	return &base
+}
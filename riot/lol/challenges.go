@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,8 +17,9 @@ type ChallengesClient struct {
 // GetConfig returns all basic challenge configuration information
 func (cc *ChallengesClient) GetConfig() ([]*ChallengeConfigInfo, error) {
 	logger := cc.logger().WithField("method", "GetConfig")
+	c := cc.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var challengeConfigs []*ChallengeConfigInfo
-	if err := cc.c.GetInto(endpointChallengesConfig, &challengeConfigs); err != nil {
+	if err := c.GetInto(endpointChallengesConfig, &challengeConfigs); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -33,8 +35,9 @@ type PercentilesByChallenges map[string]Percentiles
 // GetPercentiles returns a map of level to percentile of players who have achieved it
 func (cc *ChallengesClient) GetPercentiles() (PercentilesByChallenges, error) {
 	logger := cc.logger().WithField("method", "GetPercentiles")
+	c := cc.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var percentiles PercentilesByChallenges
-	if err := cc.c.GetInto(endpointChallengesPercentiles, &percentiles); err != nil {
+	if err := c.GetInto(endpointChallengesPercentiles, &percentiles); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -44,8 +47,9 @@ func (cc *ChallengesClient) GetPercentiles() (PercentilesByChallenges, error) {
 // GetConfigByChallengeID returns challenge configuration by ID
 func (cc *ChallengesClient) GetConfigByChallengeID(challengeID int64) (*ChallengeConfigInfo, error) {
 	logger := cc.logger().WithField("method", "GetConfigByChallengeID")
+	c := cc.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var challengeConfig *ChallengeConfigInfo
-	if err := cc.c.GetInto(
+	if err := c.GetInto(
 		fmt.Sprintf(endpointChallengesConfigByChallengeID, challengeID), &challengeConfig,
 	); err != nil {
 		logger.Debug(err)
@@ -59,6 +63,7 @@ func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 	challengeID int64, tier tier, limit int32,
 ) ([]*ApexPlayerInfo, error) {
 	logger := cc.logger().WithField("method", "GetLeaderBoardByChallengeIDAndLevel")
+	c := cc.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var apexPlayerInfo []*ApexPlayerInfo
 	if tier == "" {
 		tier = TierChallenger
@@ -66,7 +71,7 @@ func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 	if limit <= 0 {
 		limit = 50
 	}
-	if err := cc.c.GetInto(
+	if err := c.GetInto(
 		fmt.Sprintf(endpointChallengesLeaderboards, challengeID, tier, limit), &apexPlayerInfo,
 	); err != nil {
 		logger.Debug(err)
@@ -78,8 +83,9 @@ func (cc *ChallengesClient) GetLeaderBoardByChallengeIDAndLevel(
 // GetPercentilesByChallengeID returns map of level to percentiles of players who have achieved it for a challenge
 func (cc *ChallengesClient) GetPercentilesByChallengeID(challengeID int64) (Percentiles, error) {
 	logger := cc.logger().WithField("method", "GetPercentilesByChallengeID")
+	c := cc.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var percentiles Percentiles
-	if err := cc.c.GetInto(
+	if err := c.GetInto(
 		fmt.Sprintf(endpointChallengesPercentilesByChallengeID, challengeID), &percentiles,
 	); err != nil {
 		logger.Debug(err)
@@ -91,8 +97,9 @@ func (cc *ChallengesClient) GetPercentilesByChallengeID(challengeID int64) (Perc
 // GetPlayerDataByPUUID returns player information with list of all progressed challenges
 func (cc *ChallengesClient) GetPlayerDataByPUUID(uuid string) (*PlayerInfo, error) {
 	logger := cc.logger().WithField("method", "GetPlayerDataByPUUID")
+	c := cc.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var playerData *PlayerInfo
-	if err := cc.c.GetInto(fmt.Sprintf(endpointChallengesPlayerDataByPUUID, uuid), &playerData); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointChallengesPlayerDataByPUUID, uuid), &playerData); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
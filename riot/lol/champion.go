@@ -3,6 +3,7 @@ package lol
 import (
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -14,8 +15,9 @@ type ChampionClient struct {
 // GetFreeRotation returns information about the current free champion rotation
 func (c *ChampionClient) GetFreeRotation() (*ChampionInfo, error) {
 	logger := c.logger().WithField("method", "GetFreeRotation")
+	base := c.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var info *ChampionInfo
-	if err := c.c.GetInto(endpointGetFreeChampionRotation, &info); err != nil {
+	if err := base.GetInto(endpointGetFreeChampionRotation, &info); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -17,8 +18,9 @@ type ChampionMasteryClient struct {
 // ListByPuuid returns information about masteries for the summoner with the given PUUID
 func (c *ChampionMasteryClient) ListByPuuid(puuid string) ([]*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "ListByPuuid")
+	base := c.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var masteries []*ChampionMastery
-	if err := c.c.GetInto(
+	if err := base.GetInto(
 		fmt.Sprintf(endpointGetChampionMasteriesByPuuid, puuid),
 		&masteries,
 	); err != nil {
@@ -32,8 +34,9 @@ func (c *ChampionMasteryClient) ListByPuuid(puuid string) ([]*ChampionMastery, e
 // for the summoner with the given PUUID
 func (c *ChampionMasteryClient) GetByPuuid(puuid, championID string) (*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "GetByPuuid")
+	base := c.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var mastery *ChampionMastery
-	if err := c.c.GetInto(
+	if err := base.GetInto(
 		fmt.Sprintf(endpointGetChampionMasteryByPuuid, puuid, championID),
 		&mastery,
 	); err != nil {
@@ -46,8 +49,9 @@ func (c *ChampionMasteryClient) GetByPuuid(puuid, championID string) (*ChampionM
 // GetTopByPuuid returns the top champion masteries for the summoner with the given PUUID
 func (c *ChampionMasteryClient) GetTopByPuuid(puuid string, count int) ([]*ChampionMastery, error) {
 	logger := c.logger().WithField("method", "GetTopByPuuid")
+	base := c.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var masteries []*ChampionMastery
-	if err := c.c.GetInto(
+	if err := base.GetInto(
 		fmt.Sprintf(endpointGetChampionMasteriesTopByPuuid, puuid, count),
 		&masteries,
 	); err != nil {
@@ -60,8 +64,9 @@ func (c *ChampionMasteryClient) GetTopByPuuid(puuid string, count int) ([]*Champ
 // GetTotalByPuuid returns the accumulated mastery score of all champions played by the summoner with the given PUUID
 func (c *ChampionMasteryClient) GetTotalByPuuid(puuid string) (int, error) {
 	logger := c.logger().WithField("method", "GetTotalByPuuid")
+	base := c.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var score int
-	if err := c.c.GetInto(fmt.Sprintf(endpointGetChampionMasteryTotalScoreByPuuid, puuid), &score); err != nil {
+	if err := base.GetInto(fmt.Sprintf(endpointGetChampionMasteryTotalScoreByPuuid, puuid), &score); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
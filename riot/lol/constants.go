@@ -124,3 +124,11 @@ var (
 		DivisionFour,
 	}
 )
+
+// Types of item events in a match timeline
+const (
+	EventTypeItemPurchased = "ITEM_PURCHASED"
+	EventTypeItemSold      = "ITEM_SOLD"
+	EventTypeItemDestroyed = "ITEM_DESTROYED"
+	EventTypeItemUndo      = "ITEM_UNDO"
+)
@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,8 +17,9 @@ type LeagueClient struct {
 // GetChallenger returns the current Challenger league for the Region
 func (l *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetChallenger")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var list *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetChallengerLeague, queue), &list); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetChallengerLeague, queue), &list); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -27,8 +29,9 @@ func (l *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
 // GetGrandmaster returns the current Grandmaster league for the Region
 func (l *LeagueClient) GetGrandmaster(queue queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetGrandmaster")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var list *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetGrandmasterLeague, queue), &list); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetGrandmasterLeague, queue), &list); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -38,8 +41,9 @@ func (l *LeagueClient) GetGrandmaster(queue queue) (*LeagueList, error) {
 // GetMaster returns the current Master league for the Region
 func (l *LeagueClient) GetMaster(queue queue) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "GetMaster")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var list *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetMasterLeague, queue), &list); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetMasterLeague, queue), &list); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -49,8 +53,9 @@ func (l *LeagueClient) GetMaster(queue queue) (*LeagueList, error) {
 // ListByPuuid returns all leagues a summoner with the given puuid is in
 func (l *LeagueClient) ListByPuuid(puuid string) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListByPuuid")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var leagues []*LeagueItem
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeaguesByPuuid, puuid), &leagues); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetLeaguesByPuuid, puuid), &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -60,8 +65,9 @@ func (l *LeagueClient) ListByPuuid(puuid string) ([]*LeagueItem, error) {
 // ListPlayers returns all players with a league specified by its queue, tier and division
 func (l *LeagueClient) ListPlayers(queue queue, tier tier, division division) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListPlayers")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var leagues []*LeagueItem
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeagues, queue, tier, division), &leagues); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetLeagues, queue, tier, division), &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -71,8 +77,9 @@ func (l *LeagueClient) ListPlayers(queue queue, tier tier, division division) ([
 // ListExpPlayers returns all players with a league specified by its queue, tier and division
 func (l *LeagueClient) ListExpPlayers(queue queue, tier tier, division division) ([]*LeagueItem, error) {
 	logger := l.logger().WithField("method", "ListExpPlayers")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var leagues []*LeagueItem
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeagueExpEntries, queue, tier, division), &leagues); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetLeagueExpEntries, queue, tier, division), &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -82,8 +89,9 @@ func (l *LeagueClient) ListExpPlayers(queue queue, tier tier, division division)
 // Get returns a ranked league with the specified ID
 func (l *LeagueClient) Get(leagueID string) (*LeagueList, error) {
 	logger := l.logger().WithField("method", "Get")
+	c := l.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var leagues *LeagueList
-	if err := l.c.GetInto(fmt.Sprintf(endpointGetLeague, leagueID), &leagues); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetLeague, leagueID), &leagues); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
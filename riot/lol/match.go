@@ -51,8 +51,7 @@ func (mo *MatchListOptions) buildParam() string {
 // Get returns a match specified by its ID
 func (m *MatchClient) Get(id string) (*Match, error) {
 	logger := m.logger().WithField("method", "Get")
-	c := *m.c                                          // copy client
-	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
+	c := m.c.ForGame(api.GameLoL, api.FamilyRegional) // Match v5 uses a route instead of a region
 	var match *Match
 	if err := c.GetInto(fmt.Sprintf(endpointGetMatch, id), &match); err != nil {
 		logger.Debug(err)
@@ -66,8 +65,7 @@ func (m *MatchClient) List(puuid string, start, count int, options ...*MatchList
 	[]string, error,
 ) {
 	logger := m.logger().WithField("method", "List")
-	c := *m.c                                          // copy client
-	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
+	c := m.c.ForGame(api.GameLoL, api.FamilyRegional) // Match v5 uses a route instead of a region
 	var matches []string
 	endpoint := fmt.Sprintf(endpointGetMatchIDs, puuid, start, count)
 	if len(options) != 0 {
@@ -134,8 +132,7 @@ func (m *MatchClient) ListStream(puuid string, options ...*MatchListOptions) <-c
 // TODO: double check v5 implementation when struct is documented
 func (m *MatchClient) GetTimeline(id string) (*MatchTimeline, error) {
 	logger := m.logger().WithField("method", "GetTimeline")
-	c := *m.c                                          // copy client
-	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
+	c := m.c.ForGame(api.GameLoL, api.FamilyRegional) // Match v5 uses a route instead of a region
 	var timeline MatchTimeline
 	if err := c.GetInto(fmt.Sprintf(endpointGetMatchTimeline, id), &timeline); err != nil {
 		logger.Debug(err)
@@ -147,8 +144,7 @@ func (m *MatchClient) GetTimeline(id string) (*MatchTimeline, error) {
 // GetReplays returns the replays for the given puuid
 func (m *MatchClient) GetReplays(puuid string) (*MatchReplays, error) {
 	logger := m.logger().WithField("method", "GetReplays")
-	c := *m.c                                          // copy client
-	c.Region = api.Region(api.RegionToRoute[c.Region]) // Match v5 uses a route instead of a region
+	c := m.c.ForGame(api.GameLoL, api.FamilyRegional) // Match v5 uses a route instead of a region
 	var replays MatchReplays
 	if err := c.GetInto(fmt.Sprintf(endpointGetMatchReplays, puuid), &replays); err != nil {
 		logger.Debug(err)
@@ -153,15 +153,22 @@ func TestMatchClient_GetTimeline(t *testing.T) {
 		region  api.Region
 	}{
 		{
-			name: "get response",
-			want: &MatchTimeline{},
-			doer: mock.NewJSONMockDoer(MatchTimeline{}, 200),
+			name:   "get response",
+			want:   &MatchTimeline{},
+			region: api.RegionEuropeWest,
+			doer:   mock.NewJSONMockDoer(MatchTimeline{}, 200),
 		},
 		{
 			name:    "not found",
 			wantErr: api.ErrNotFound,
+			region:  api.RegionEuropeWest,
 			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
 		},
+		{
+			name:    "unknown region",
+			wantErr: api.ErrUnknownRegion,
+			doer:    mock.NewJSONMockDoer(MatchTimeline{}, 200),
+		},
 		{
 			name:   "correct host",
 			want:   &MatchTimeline{},
@@ -180,7 +187,7 @@ func TestMatchClient_GetTimeline(t *testing.T) {
 			tt.name, func(t *testing.T) {
 				client := internal.NewClient(tt.region, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&MatchClient{c: client}).GetTimeline("0")
-				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				require.ErrorIs(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
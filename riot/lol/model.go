@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/datadragon"
 	"github.com/KnutZuidema/golio/static"
 )
@@ -21,7 +22,7 @@ type ChampionInfo struct {
 func (i *ChampionInfo) GetChampionsForNewPlayers(client *datadragon.Client) ([]datadragon.ChampionDataExtended, error) {
 	res := make([]datadragon.ChampionDataExtended, 0, len(i.FreeChampionIDsForNewPlayers))
 	for _, id := range i.FreeChampionIDsForNewPlayers {
-		champion, err := client.GetChampionByID(strconv.Itoa(id))
+		champion, err := client.GetChampionByKey(id)
 		if err != nil {
 			return nil, err
 		}
@@ -32,9 +33,9 @@ func (i *ChampionInfo) GetChampionsForNewPlayers(client *datadragon.Client) ([]d
 
 // GetChampions returns data for champions available for free
 func (i *ChampionInfo) GetChampions(client *datadragon.Client) ([]datadragon.ChampionDataExtended, error) {
-	res := make([]datadragon.ChampionDataExtended, 0, len(i.FreeChampionIDsForNewPlayers))
+	res := make([]datadragon.ChampionDataExtended, 0, len(i.FreeChampionIDs))
 	for _, id := range i.FreeChampionIDs {
-		champion, err := client.GetChampionByID(strconv.Itoa(id))
+		champion, err := client.GetChampionByKey(id)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +84,7 @@ func (m *ChampionMastery) GetSummoner(client *Client) (*Summoner, error) {
 
 // GetChampion returns the champion of this mastery
 func (m *ChampionMastery) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(m.ChampionID))
+	return client.GetChampionByKey(m.ChampionID)
 }
 
 // LeagueList represents a league containing all player entries in it
@@ -220,6 +221,11 @@ func (m *MatchInfo) GetGameMode(client *static.Client) (static.GameMode, error)
 	return client.GetGameMode(m.GameMode)
 }
 
+// GetDataDragon returns a Data Dragon client serving data of the patch this match was played on
+func (m *MatchInfo) GetDataDragon(client *datadragon.Client) (*datadragon.Client, error) {
+	return client.ForGameVersion(m.GameVersion)
+}
+
 // StatPerks hold stats for a perk
 type StatPerks struct {
 	Defense int `json:"defense"`
@@ -242,12 +248,31 @@ type Styles struct {
 	Style       int          `json:"style"`
 }
 
+// Descriptions of the perk styles of a participant
+const (
+	PerkStylePrimary   = "primaryStyle"
+	PerkStyleSecondary = "subStyle"
+)
+
 // ParticipantPerks holds the perks for a participant in a match
 type ParticipantPerks struct {
 	StatPerks *StatPerks `json:"statPerks"`
 	Styles    []Styles   `json:"styles"`
 }
 
+// style returns the perk style with the given description
+func (p *ParticipantPerks) style(description string) *Styles {
+	if p == nil {
+		return nil
+	}
+	for i := range p.Styles {
+		if p.Styles[i].Description == description {
+			return &p.Styles[i]
+		}
+	}
+	return nil
+}
+
 // Participant hold information for a participant of a match
 type Participant struct {
 	Assists         int `json:"assists"`
@@ -388,7 +413,7 @@ func (p *Participant) GetProfileIcon(client *datadragon.Client) (datadragon.Prof
 
 // GetChampion returns the champion played by this participant
 func (p *Participant) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(p.ChampionID))
+	return client.GetChampionByKey(p.ChampionID)
 }
 
 // GetSpell1 returns the first summoner spell of this participant
@@ -436,6 +461,56 @@ func (p *Participant) GetItem6(client *datadragon.Client) (datadragon.Item, erro
 	return client.GetItem(strconv.Itoa(p.Item6))
 }
 
+// GetPrimaryRunePath returns the primary rune path chosen by this participant
+func (p *Participant) GetPrimaryRunePath(client *datadragon.Client) (datadragon.RunePath, error) {
+	style := p.Perks.style(PerkStylePrimary)
+	if style == nil {
+		return datadragon.RunePath{}, api.ErrNotFound
+	}
+	return client.GetRunePath(style.Style)
+}
+
+// GetSecondaryRunePath returns the secondary rune path chosen by this participant
+func (p *Participant) GetSecondaryRunePath(client *datadragon.Client) (datadragon.RunePath, error) {
+	style := p.Perks.style(PerkStyleSecondary)
+	if style == nil {
+		return datadragon.RunePath{}, api.ErrNotFound
+	}
+	return client.GetRunePath(style.Style)
+}
+
+// GetKeystone returns the keystone rune chosen by this participant
+func (p *Participant) GetKeystone(client *datadragon.Client) (datadragon.RuneReforged, error) {
+	style := p.Perks.style(PerkStylePrimary)
+	if style == nil || len(style.Selections) == 0 {
+		return datadragon.RuneReforged{}, api.ErrNotFound
+	}
+	return client.GetRuneReforged(style.Selections[0].Perk)
+}
+
+// GetRunes returns all runes chosen by this participant, starting with the primary rune path
+func (p *Participant) GetRunes(client *datadragon.Client) ([]datadragon.RuneReforged, error) {
+	var ids []int
+	for _, description := range []string{PerkStylePrimary, PerkStyleSecondary} {
+		if style := p.Perks.style(description); style != nil {
+			for _, selection := range style.Selections {
+				ids = append(ids, selection.Perk)
+			}
+		}
+	}
+	return getRunes(client, ids)
+}
+
+// GetStatShards returns the offense, flex and defense stat shards chosen by this participant
+func (p *Participant) GetStatShards() ([]datadragon.StatShard, error) {
+	if p.Perks == nil || p.Perks.StatPerks == nil {
+		return nil, api.ErrNotFound
+	}
+	return getStatShards(
+		[]int{p.Perks.StatPerks.Offense, p.Perks.StatPerks.Flex, p.Perks.StatPerks.Defense},
+	)
+}
+
 // TeamBan is a champion banned by a team
 type TeamBan struct {
 	// Turn during which the champion was banned.
@@ -446,7 +521,7 @@ type TeamBan struct {
 
 // GetChampion returns the champion that was banned
 func (b *TeamBan) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(b.ChampionID))
+	return client.GetChampionByKey(b.ChampionID)
 }
 
 // Objective holds information for a single objective
@@ -551,6 +626,22 @@ type EventsTimeline struct {
 	WinningTeam             *int           `json:"winningTeam,omitempty"`
 }
 
+// GetItem returns the item of an item event, e.g. ITEM_PURCHASED or ITEM_SOLD. For ITEM_UNDO events the
+// item is the one whose purchase or sale was undone.
+func (e *EventsTimeline) GetItem(client *datadragon.Client) (datadragon.Item, error) {
+	id := e.ItemID
+	if id == nil && e.Type == EventTypeItemUndo {
+		id = e.BeforeID
+		if id != nil && *id == 0 {
+			id = e.AfterID
+		}
+	}
+	if id == nil || *id == 0 {
+		return datadragon.Item{}, api.ErrNotFound
+	}
+	return client.GetItem(strconv.Itoa(*id))
+}
+
 // EventPosition holds the position of an event on the map
 type EventPosition struct {
 	X int `json:"x"`
@@ -666,7 +757,7 @@ type BannedChampion struct {
 
 // GetChampion returns the banned champion
 func (c *BannedChampion) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(c.ChampionID))
+	return client.GetChampionByKey(c.ChampionID)
 }
 
 // Observer is an observer of an ongoing game
@@ -690,7 +781,7 @@ type CurrentGameParticipant struct {
 
 // GetChampion returns the champion played by this participant
 func (p *CurrentGameParticipant) GetChampion(client *datadragon.Client) (datadragon.ChampionDataExtended, error) {
-	return client.GetChampionByID(strconv.Itoa(p.ChampionID))
+	return client.GetChampionByKey(p.ChampionID)
 }
 
 // GetSpell1 returns the first summoner spell of this participant
@@ -703,6 +794,58 @@ func (p *CurrentGameParticipant) GetSpell2(client *datadragon.Client) (datadrago
 	return client.GetSummonerSpell(strconv.Itoa(p.Spell2ID))
 }
 
+// GetPrimaryRunePath returns the primary rune path chosen by this participant
+func (p *CurrentGameParticipant) GetPrimaryRunePath(client *datadragon.Client) (datadragon.RunePath, error) {
+	if p.Perks == nil {
+		return datadragon.RunePath{}, api.ErrNotFound
+	}
+	return client.GetRunePath(p.Perks.PerkStyle)
+}
+
+// GetSecondaryRunePath returns the secondary rune path chosen by this participant
+func (p *CurrentGameParticipant) GetSecondaryRunePath(client *datadragon.Client) (datadragon.RunePath, error) {
+	if p.Perks == nil {
+		return datadragon.RunePath{}, api.ErrNotFound
+	}
+	return client.GetRunePath(p.Perks.PerkSubStyle)
+}
+
+// GetKeystone returns the keystone rune chosen by this participant
+func (p *CurrentGameParticipant) GetKeystone(client *datadragon.Client) (datadragon.RuneReforged, error) {
+	if p.Perks == nil || len(p.Perks.PerksIDs) == 0 {
+		return datadragon.RuneReforged{}, api.ErrNotFound
+	}
+	return client.GetRuneReforged(p.Perks.PerksIDs[0])
+}
+
+// GetRunes returns all runes chosen by this participant, starting with the primary rune path
+func (p *CurrentGameParticipant) GetRunes(client *datadragon.Client) ([]datadragon.RuneReforged, error) {
+	if p.Perks == nil {
+		return nil, api.ErrNotFound
+	}
+	var ids []int
+	for _, id := range p.Perks.PerksIDs {
+		if _, ok := datadragon.StatShards[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	return getRunes(client, ids)
+}
+
+// GetStatShards returns the stat shards chosen by this participant
+func (p *CurrentGameParticipant) GetStatShards() ([]datadragon.StatShard, error) {
+	if p.Perks == nil {
+		return nil, api.ErrNotFound
+	}
+	var ids []int
+	for _, id := range p.Perks.PerksIDs {
+		if _, ok := datadragon.StatShards[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return getStatShards(ids)
+}
+
 // GameCustomizationObject contains information specific to an ongoing game
 type GameCustomizationObject struct {
 	Category string `json:"category"`
@@ -907,3 +1050,27 @@ type MatchReplays struct {
 	Total         int      `json:"total"`
 	MatchFileURLs []string `json:"matchFileURLs"`
 }
+
+func getRunes(client *datadragon.Client, ids []int) ([]datadragon.RuneReforged, error) {
+	runes := make([]datadragon.RuneReforged, 0, len(ids))
+	for _, id := range ids {
+		r, err := client.GetRuneReforged(id)
+		if err != nil {
+			return nil, err
+		}
+		runes = append(runes, r)
+	}
+	return runes, nil
+}
+
+func getStatShards(ids []int) ([]datadragon.StatShard, error) {
+	shards := make([]datadragon.StatShard, 0, len(ids))
+	for _, id := range ids {
+		shard, err := datadragon.GetStatShard(id)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, shard)
+	}
+	return shards, nil
+}
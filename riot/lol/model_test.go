@@ -60,7 +60,7 @@ func TestChampionInfo_GetChampionsForNewPlayers(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
 					"1": {Key: "1", ID: "1", Name: "champion1"},
 					"2": {Key: "2", ID: "2", Name: "champion2"},
@@ -109,7 +109,7 @@ func TestChampionInfo_GetChampions(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
 					"1": {Key: "1", ID: "1", Name: "champion1"},
 					"2": {Key: "2", ID: "2", Name: "champion2"},
@@ -186,7 +186,7 @@ func TestChampionMastery_GetChampion(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
 					"1": {Key: "1", ID: "1", Name: "champion"},
 				},
@@ -265,6 +265,42 @@ func TestMatchInfo_GetQueue(t *testing.T) {
 	}
 }
 
+func TestMatchInfo_GetDataDragon(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    internal.Doer
+		model   MatchInfo
+		want    string
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			doer:  mock.NewJSONMockDoer([]string{"14.4.1", "14.3.1"}, 200),
+			model: MatchInfo{GameVersion: "14.3.562.1234"},
+			want:  "14.3.1",
+		},
+		{
+			name:    "unknown version",
+			doer:    mock.NewJSONMockDoer([]string{"14.4.1", "14.3.1"}, 200),
+			model:   MatchInfo{GameVersion: "13.1.1.1"},
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				client := datadragon.NewClient(test.doer, api.RegionKorea, log.StandardLogger())
+				got, err := test.model.GetDataDragon(client)
+				assert.Equal(t, test.wantErr, err)
+				if test.wantErr == nil {
+					assert.Equal(t, test.want, got.Version)
+				}
+			},
+		)
+	}
+}
+
 func TestMatchInfo_GetMap(t *testing.T) {
 	type test struct {
 		name    string
@@ -388,7 +424,7 @@ func TestParticipant_GetProfileIcon(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ProfileIcon{
 					"champion": {ID: 1},
 				},
@@ -420,7 +456,7 @@ func TestTeamBan_GetChampion(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
 					"1": {Key: "1", ID: "1", Name: "champion"},
 				},
@@ -454,14 +490,14 @@ func TestParticipant_GetChampion(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
-					"1": {Key: "1", ID: "1", Name: "champion"},
+					"Ashe": {Key: "22", ID: "Ashe", Name: "Ashe"},
 				},
 			),
-			model: Participant{ChampionID: 1},
+			model: Participant{ChampionID: 22},
 			want: datadragon.ChampionDataExtended{
-				ChampionData: datadragon.ChampionData{ID: "1", Name: "champion", Key: "1"},
+				ChampionData: datadragon.ChampionData{ID: "Ashe", Name: "Ashe", Key: "22"},
 			},
 		},
 	}
@@ -488,7 +524,7 @@ func TestParticipant_GetSpell1(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.SummonerSpell{
 					"champion": {Key: "1"},
 				},
@@ -520,7 +556,7 @@ func TestParticipant_GetSpell2(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.SummonerSpell{
 					"champion": {Key: "2"},
 				},
@@ -552,7 +588,7 @@ func TestParticipant_GetItem0(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -584,7 +620,7 @@ func TestParticipant_GetItem1(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -616,7 +652,7 @@ func TestParticipant_GetItem2(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -648,7 +684,7 @@ func TestParticipant_GetItem3(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -680,7 +716,7 @@ func TestParticipant_GetItem4(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -712,7 +748,7 @@ func TestParticipant_GetItem5(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -744,7 +780,7 @@ func TestParticipant_GetItem6(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.Item{
 					"1": {},
 				},
@@ -765,6 +801,49 @@ func TestParticipant_GetItem6(t *testing.T) {
 	}
 }
 
+func TestEventsTimeline_GetItem(t *testing.T) {
+	zero, sold, before := 0, 1036, 3133
+	type test struct {
+		name    string
+		model   EventsTimeline
+		want    datadragon.Item
+		wantErr error
+	}
+	tests := []test{
+		{
+			name:  "purchase",
+			model: EventsTimeline{Type: EventTypeItemPurchased, ItemID: &sold},
+			want:  datadragon.Item{ID: "1036"},
+		},
+		{
+			name:  "undo purchase",
+			model: EventsTimeline{Type: EventTypeItemUndo, BeforeID: &before, AfterID: &zero},
+			want:  datadragon.Item{ID: "3133"},
+		},
+		{
+			name:  "undo sale",
+			model: EventsTimeline{Type: EventTypeItemUndo, BeforeID: &zero, AfterID: &sold},
+			want:  datadragon.Item{ID: "1036"},
+		},
+		{
+			name:    "no item",
+			model:   EventsTimeline{Type: "CHAMPION_KILL"},
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				doer := mock.NewDataDragonMockDoer(map[string]datadragon.Item{"1036": {}, "3133": {}})
+				client := datadragon.NewClient(doer, api.RegionKorea, log.StandardLogger())
+				got, err := test.model.GetItem(client)
+				assert.Equal(t, test.wantErr, err)
+				assert.Equal(t, test.want, got)
+			},
+		)
+	}
+}
+
 func TestBannedChampion_GetChampion(t *testing.T) {
 	type test struct {
 		name    string
@@ -776,7 +855,7 @@ func TestBannedChampion_GetChampion(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
 					"1": {Key: "1", ID: "1", Name: "champion"},
 				},
@@ -810,7 +889,7 @@ func TestCurrentGameParticipant_GetChampion(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.ChampionData{
 					"1": {Key: "1", ID: "1", Name: "champion"},
 				},
@@ -844,7 +923,7 @@ func TestCurrentGameParticipant_GetSpell1(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.SummonerSpell{
 					"champion": {Key: "1"},
 				},
@@ -876,7 +955,7 @@ func TestCurrentGameParticipant_GetSpell2(t *testing.T) {
 	tests := []test{
 		{
 			name: "valid",
-			doer: dataDragonResponseDoer(
+			doer: mock.NewDataDragonMockDoer(
 				map[string]datadragon.SummonerSpell{
 					"champion": {Key: "2"},
 				},
@@ -925,17 +1004,117 @@ func TestGameInfo_GetMatch(t *testing.T) {
 	}
 }
 
-type dataDragonResponse struct {
-	Type    string
-	Format  string
-	Version string
-	Data    any
+var testRunePaths = []datadragon.RunePath{
+	{
+		ID: 8000,
+		Slots: []datadragon.RuneSlot{
+			{Runes: []datadragon.RuneReforged{{ID: 8005}, {ID: 8021}}},
+			{Runes: []datadragon.RuneReforged{{ID: 9101}}},
+		},
+	},
+	{
+		ID:    8100,
+		Slots: []datadragon.RuneSlot{{Runes: []datadragon.RuneReforged{{ID: 8139}}}},
+	},
 }
 
-func dataDragonResponseDoer(object any) internal.Doer {
-	return mock.NewJSONMockDoer(
-		dataDragonResponse{
-			Data: object,
-		}, 200,
+func TestParticipant_Runes(t *testing.T) {
+	t.Parallel()
+	client := datadragon.NewClient(
+		mock.NewJSONMockDoer(testRunePaths, 200), api.RegionKorea, log.StandardLogger(),
 	)
+	p := Participant{
+		Perks: &ParticipantPerks{
+			StatPerks: &StatPerks{Offense: 5005, Flex: 5008, Defense: 5011},
+			Styles: []Styles{
+				{
+					Description: PerkStylePrimary,
+					Style:       8000,
+					Selections:  []Selections{{Perk: 8021}, {Perk: 9101}},
+				},
+				{
+					Description: PerkStyleSecondary,
+					Style:       8100,
+					Selections:  []Selections{{Perk: 8139}},
+				},
+			},
+		},
+	}
+	primary, err := p.GetPrimaryRunePath(client)
+	require.Nil(t, err)
+	assert.Equal(t, testRunePaths[0], primary)
+	secondary, err := p.GetSecondaryRunePath(client)
+	require.Nil(t, err)
+	assert.Equal(t, testRunePaths[1], secondary)
+	keystone, err := p.GetKeystone(client)
+	require.Nil(t, err)
+	assert.Equal(t, datadragon.RuneReforged{ID: 8021}, keystone)
+	runes, err := p.GetRunes(client)
+	require.Nil(t, err)
+	assert.Equal(t, []datadragon.RuneReforged{{ID: 8021}, {ID: 9101}, {ID: 8139}}, runes)
+	shards, err := p.GetStatShards()
+	require.Nil(t, err)
+	assert.Equal(
+		t, []datadragon.StatShard{
+			datadragon.StatShards[5005], datadragon.StatShards[5008], datadragon.StatShards[5011],
+		}, shards,
+	)
+
+	empty := Participant{}
+	_, err = empty.GetPrimaryRunePath(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetSecondaryRunePath(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetKeystone(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetStatShards()
+	assert.Equal(t, api.ErrNotFound, err)
+	p.Perks.Styles[1].Selections[0].Perk = 1
+	_, err = p.GetRunes(client)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestCurrentGameParticipant_Runes(t *testing.T) {
+	t.Parallel()
+	client := datadragon.NewClient(
+		mock.NewJSONMockDoer(testRunePaths, 200), api.RegionKorea, log.StandardLogger(),
+	)
+	p := CurrentGameParticipant{
+		Perks: &Perks{
+			PerkStyle:    8000,
+			PerkSubStyle: 8100,
+			PerksIDs:     []int{8005, 9101, 8139, 5005, 5008, 5001},
+		},
+	}
+	primary, err := p.GetPrimaryRunePath(client)
+	require.Nil(t, err)
+	assert.Equal(t, testRunePaths[0], primary)
+	secondary, err := p.GetSecondaryRunePath(client)
+	require.Nil(t, err)
+	assert.Equal(t, testRunePaths[1], secondary)
+	keystone, err := p.GetKeystone(client)
+	require.Nil(t, err)
+	assert.Equal(t, datadragon.RuneReforged{ID: 8005}, keystone)
+	runes, err := p.GetRunes(client)
+	require.Nil(t, err)
+	assert.Equal(t, []datadragon.RuneReforged{{ID: 8005}, {ID: 9101}, {ID: 8139}}, runes)
+	shards, err := p.GetStatShards()
+	require.Nil(t, err)
+	assert.Equal(
+		t, []datadragon.StatShard{
+			datadragon.StatShards[5005], datadragon.StatShards[5008], datadragon.StatShards[5001],
+		}, shards,
+	)
+
+	empty := CurrentGameParticipant{}
+	_, err = empty.GetPrimaryRunePath(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetSecondaryRunePath(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetKeystone(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetRunes(client)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = empty.GetStatShards()
+	assert.Equal(t, api.ErrNotFound, err)
 }
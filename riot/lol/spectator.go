@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,8 +17,9 @@ type SpectatorClient struct {
 // GetCurrent returns a currently running game for a summoner
 func (s *SpectatorClient) GetCurrent(puuid string) (*GameInfo, error) {
 	logger := s.logger().WithField("method", "GetCurrent")
+	c := s.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var games GameInfo
-	if err := s.c.GetInto(fmt.Sprintf(endpointGetCurrentGame, puuid), &games); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetCurrentGame, puuid), &games); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -27,8 +29,9 @@ func (s *SpectatorClient) GetCurrent(puuid string) (*GameInfo, error) {
 // ListFeatured returns the currently featured games
 func (s *SpectatorClient) ListFeatured() (*FeaturedGames, error) {
 	logger := s.logger().WithField("method", "ListFeatured")
+	c := s.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var games FeaturedGames
-	if err := s.c.GetInto(endpointGetFeaturedGames, &games); err != nil {
+	if err := c.GetInto(endpointGetFeaturedGames, &games); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
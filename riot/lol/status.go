@@ -3,6 +3,7 @@ package lol
 import (
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -14,8 +15,9 @@ type StatusClient struct {
 // Get returns the current status of the services for the Region
 func (s *StatusClient) Get() (*Status, error) {
 	logger := s.logger().WithField("method", "Get")
+	c := s.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var status *Status
-	if err := s.c.GetInto(endpointGetStatus, &status); err != nil {
+	if err := c.GetInto(endpointGetStatus, &status); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,8 +17,9 @@ type SummonerClient struct {
 // GetByPUUID returns the summoner with the given PUUID
 func (s *SummonerClient) GetByPUUID(puuid string) (*Summoner, error) {
 	logger := s.logger().WithField("method", "GetByPUUID")
+	c := s.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var summoner *Summoner
-	if err := s.c.GetInto(fmt.Sprintf(endpointGetSummonerByPUUID, puuid), &summoner); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetSummonerByPUUID, puuid), &summoner); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -27,8 +29,9 @@ func (s *SummonerClient) GetByPUUID(puuid string) (*Summoner, error) {
 // GetMe returns the summoner for the given access token
 func (s *SummonerClient) GetMe(accessToken string) (*Summoner, error) {
 	logger := s.logger().WithField("method", "GetMe")
+	c := s.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var summoner *Summoner
-	if err := s.c.GetInto(
+	if err := c.GetInto(
 		endpointGetSummonerMe,
 		&summoner,
 		internal.WithHeader("Authorization", "Bearer "+accessToken),
@@ -79,3 +79,24 @@ func TestSummonerClient_GetMe(t *testing.T) {
 		)
 	}
 }
+
+func TestSummonerClient_Routing(t *testing.T) {
+	t.Parallel()
+	var host string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			host = r.URL.Host
+			return mock.NewJSONMockDoer(Summoner{}, http.StatusOK).Do(r)
+		},
+	}
+	client := &SummonerClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+	_, err := client.GetByPUUID("puuid")
+	assert.NoError(t, err)
+	assert.Equal(t, "euw1.api.riotgames.com", host)
+
+	// platform endpoints can not be served by a regional route
+	route := api.Region(api.RouteEurope)
+	client = &SummonerClient{c: internal.NewClient(route, "API_KEY", doer, logrus.StandardLogger())}
+	_, err = client.GetByPUUID("puuid")
+	assert.ErrorIs(t, err, api.ErrUnknownRegion)
+}
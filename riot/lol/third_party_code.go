@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -21,8 +22,9 @@ func (t *ThirdPartyCodeClient) Get(puuid string) (string, error) {
 			logFieldMethod: "Get",
 		},
 	)
+	c := t.c.ForGame(api.GameLoL, api.FamilyPlatform)
 	var code string
-	if err := t.c.GetInto(fmt.Sprintf(endpointGetThirdPartyCode, puuid), &code); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetThirdPartyCode, puuid), &code); err != nil {
 		logger.Debug(err)
 		return "", err
 	}
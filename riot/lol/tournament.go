@@ -24,13 +24,13 @@ func (t *TournamentClient) CreateCodes(id, count int, params *TournamentCodePara
 			logFieldStub:   stub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.americas()
 	endpoint := endpointCreateTournamentCodes
 	if stub {
 		endpoint = endpointCreateStubTournamentCodes
 	}
 	var codes []string
-	if err := t.c.PostInto(fmt.Sprintf(endpoint, count, id), params, &codes); err != nil {
+	if err := c.PostInto(fmt.Sprintf(endpoint, count, id), params, &codes); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -46,13 +46,13 @@ func (t *TournamentClient) ListLobbyEvents(code string, useStub bool) (*LobbyEve
 			logFieldStub:   useStub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.americas()
 	endpoint := endpointGetLobbyEvents
 	if useStub {
 		endpoint = endpointGetStubLobbyEvents
 	}
 	var events LobbyEventList
-	if err := t.c.GetInto(fmt.Sprintf(endpoint, code), &events); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpoint, code), &events); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -69,13 +69,13 @@ func (t *TournamentClient) CreateProvider(parameters *ProviderRegistrationParame
 			logFieldStub:   useStub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.americas()
 	endpoint := endpointCreateTournamentProvider
 	if useStub {
 		endpoint = endpointCreateStubTournamentProvider
 	}
 	var id int
-	if err := t.c.PostInto(endpoint, parameters, &id); err != nil {
+	if err := c.PostInto(endpoint, parameters, &id); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
@@ -92,13 +92,13 @@ func (t *TournamentClient) Create(parameters *TournamentRegistrationParameters,
 			logFieldStub:   useStub,
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
+	c := t.americas()
 	endpoint := endpointCreateTournament
 	if useStub {
 		endpoint = endpointCreateStubTournament
 	}
 	var id int
-	if err := t.c.PostInto(endpoint, parameters, &id); err != nil {
+	if err := c.PostInto(endpoint, parameters, &id); err != nil {
 		logger.Debug(err)
 		return 0, err
 	}
@@ -112,8 +112,9 @@ func (t *TournamentClient) Get(code string) (*Tournament, error) {
 			logFieldMethod: "Get",
 		},
 	)
+	c := t.americas()
 	var tournament Tournament
-	if err := t.c.GetInto(fmt.Sprintf(endpointGetTournament, code), &tournament); err != nil {
+	if err := c.GetInto(fmt.Sprintf(endpointGetTournament, code), &tournament); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -127,14 +128,22 @@ func (t *TournamentClient) Update(code string, parameters TournamentUpdateParame
 			logFieldMethod: "Update",
 		},
 	)
-	t.c.Region = api.Region(api.RegionToRoute[t.c.Region])
-	if err := t.c.Put(fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
+	c := t.americas()
+	if err := c.Put(fmt.Sprintf(endpointUpdateTournament, code), parameters); err != nil {
 		logger.Debug(err)
 		return err
 	}
 	return nil
 }
 
+// americas returns a copy of the client sending requests to the americas route, which serves the tournament
+// endpoints for all regions
+func (t *TournamentClient) americas() *internal.Client {
+	c := *t.c
+	c.Region = api.Region(api.RouteAmericas)
+	return &c
+}
+
 func (t *TournamentClient) logger() log.FieldLogger {
 	return t.c.Logger().WithField("category", "tournament")
 }
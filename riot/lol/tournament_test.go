@@ -3,6 +3,7 @@ package lol
 import (
 	"fmt"
 	"net/http"
+	"slices"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -47,6 +48,25 @@ func TestTournamentClient_CreateCodes(t *testing.T) {
 	}
 }
 
+func TestTournamentClient_Routing(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			hosts = append(hosts, r.URL.Host)
+			return mock.NewJSONMockDoer([]string{}, http.StatusOK).Do(r)
+		},
+	}
+	for _, region := range []api.Region{api.RegionEuropeWest, api.RegionKorea, api.RegionNorthAmerica} {
+		client := &TournamentClient{c: internal.NewClient(region, "API_KEY", doer, logrus.StandardLogger())}
+		_, err := client.CreateCodes(0, 0, &TournamentCodeParameters{}, true)
+		require.NoError(t, err)
+		assert.Equal(t, region, client.c.Region)
+	}
+	assert.Equal(t, []string{"americas.api.riotgames.com"}, slices.Compact(hosts))
+	assert.Len(t, hosts, 3)
+}
+
 func TestTournamentClient_ListLobbyEvents(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
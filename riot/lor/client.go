@@ -1,15 +1,35 @@
 package lor
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/riot/account"
+)
 
 // Client pools methods for the Legends of Runeterra API.
 type Client struct {
-	Ranked *RankedClient
+	Ranked    *RankedClient
+	Match     *MatchClient
+	Status    *StatusClient
+	Deck      *DeckClient
+	Inventory *InventoryClient
 }
 
 // NewClient returns a new instance of a Legends of Runeterra client.
 func NewClient(base *internal.Client) *Client {
 	return &Client{
-		Ranked: &RankedClient{c: base},
+		Ranked:    &RankedClient{c: base},
+		Match:     &MatchClient{c: base},
+		Status:    &StatusClient{c: base},
+		Deck:      &DeckClient{c: base},
+		Inventory: &InventoryClient{c: base},
 	}
 }
+
+// WithActiveShards returns a copy of the client whose player-scoped methods send requests to the active shard of
+// the player, which is looked up using the given cache. This allows querying players of all shards with a single
+// client.
+func (c *Client) WithActiveShards(shards *account.ShardCache) *Client {
+	res := *c
+	res.Match = &MatchClient{c: c.Match.c, shards: shards}
+	return &res
+}
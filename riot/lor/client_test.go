@@ -1,13 +1,18 @@
 package lor
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/account"
 )
 
 func TestNewClient(t *testing.T) {
@@ -16,3 +21,39 @@ func TestNewClient(t *testing.T) {
 		t.Error("returned nil")
 	}
 }
+
+func TestClient_WithActiveShards(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			if strings.Contains(r.URL.Path, "/active-shards/") {
+				return mock.NewJSONMockDoer(account.ActiveShard{ActiveShard: "sea"}, http.StatusOK).Do(r)
+			}
+			hosts = append(hosts, r.URL.Host)
+			if strings.Contains(r.URL.Path, "/matches/by-puuid/") {
+				return mock.NewJSONMockDoer([]string{"match"}, http.StatusOK).Do(r)
+			}
+			return mock.NewJSONMockDoer(Match{}, http.StatusOK).Do(r)
+		},
+	}
+	base := internal.NewClient(api.RegionEuropeWest, "key", doer, logrus.StandardLogger())
+	client := NewClient(base)
+	routed := client.WithActiveShards(account.NewShardCache(account.NewClient(base), account.DefaultShardTTL))
+	got, err := routed.Match.GetMatchesByPUUID("puuid")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"match"}, got)
+	_, err = client.Match.GetMatchesByPUUID("puuid")
+	require.NoError(t, err)
+	player, err := routed.Match.ForPlayer("puuid")
+	require.NoError(t, err)
+	_, err = player.GetMatchByID("match")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sea.api.riotgames.com", "europe.api.riotgames.com", "sea.api.riotgames.com"}, hosts)
+
+	notFound := mock.NewStatusMockDoer(http.StatusNotFound)
+	failing := NewClient(internal.NewClient(api.RegionEuropeWest, "key", notFound, logrus.StandardLogger()))
+	shards := account.NewShardCache(account.NewClient(failing.Match.c), account.DefaultShardTTL)
+	_, err = failing.WithActiveShards(shards).Match.GetMatchesByPUUID("puuid")
+	assert.Equal(t, api.ErrNotFound, err)
+}
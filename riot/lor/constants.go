@@ -1,6 +1,21 @@
 package lor
 
 const (
-	endpointBase      = "/lor"
-	endpointGetMaster = endpointBase + "/ranked/v1/leaderboards"
+	endpointBase            = "/lor"
+	endpointGetMaster       = endpointBase + "/ranked/v1/leaderboards"
+	endpointMatchBase       = endpointBase + "/match/v1/matches"
+	endpointMatchesByPUUID  = endpointMatchBase + "/by-puuid/%s/ids"
+	endpointMatchByID       = endpointMatchBase + "/%s"
+	endpointStatusBase      = endpointBase + "/status/v1"
+	endpointPlatformData    = endpointStatusBase + "/platform-data"
+	endpointDecks           = endpointBase + "/deck/v1/decks/me"
+	endpointInventoryCards  = endpointBase + "/inventory/v1/cards/me"
+	authorizationHeaderName = "Authorization"
+)
+
+// Outcomes of a player in a match
+const (
+	GameOutcomeWin  = "win"
+	GameOutcomeLoss = "loss"
+	GameOutcomeTie  = "tie"
 )
@@ -0,0 +1,46 @@
+package lor
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// DeckClient provides methods for the deck endpoints of the Legends of Runeterra API. All endpoints require an
+// access token of the player obtained through Riot Sign On.
+type DeckClient struct {
+	c *internal.Client
+}
+
+// GetDecks returns the decks of the player authorized by the given access token
+func (dc *DeckClient) GetDecks(accessToken string) ([]*Deck, error) {
+	logger := dc.logger().WithField("method", "GetDecks")
+	var out []*Deck
+	c := dc.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err := c.GetInto(
+		endpointDecks, &out, internal.WithHeader(authorizationHeaderName, "Bearer "+accessToken),
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateDeck creates a deck for the player authorized by the given access token and returns its deck code
+func (dc *DeckClient) CreateDeck(accessToken string, deck NewDeck) (string, error) {
+	logger := dc.logger().WithField("method", "CreateDeck")
+	var out string
+	c := dc.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err := c.PostInto(
+		endpointDecks, deck, &out, internal.WithHeader(authorizationHeaderName, "Bearer "+accessToken),
+	); err != nil {
+		logger.Debug(err)
+		return "", err
+	}
+	return out, nil
+}
+
+func (dc *DeckClient) logger() log.FieldLogger {
+	return dc.c.Logger().WithField("category", "deck")
+}
@@ -0,0 +1,251 @@
+package lor
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// deckCodeFormat is the only known format of deck codes
+const deckCodeFormat = 1
+
+// MaxDeckCodeVersion is the highest version of deck codes which can be decoded
+const MaxDeckCodeVersion = 5
+
+// cardCodeLength is the length of a card code, e.g. "01DE001"
+const cardCodeLength = 7
+
+// ErrInvalidDeckCode is returned if a deck code can not be decoded or a deck can not be encoded
+var ErrInvalidDeckCode = errors.New("invalid deck code")
+
+var deckCodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// faction is a region of cards with its ID used in deck codes and the first deck code version supporting it
+type faction struct {
+	id      int
+	code    string
+	version int
+}
+
+var factions = []faction{
+	{id: 0, code: "DE", version: 1},
+	{id: 1, code: "FR", version: 1},
+	{id: 2, code: "IO", version: 1},
+	{id: 3, code: "NX", version: 1},
+	{id: 4, code: "PZ", version: 1},
+	{id: 5, code: "SI", version: 1},
+	{id: 6, code: "BW", version: 2},
+	{id: 7, code: "SH", version: 3},
+	{id: 9, code: "MT", version: 2},
+	{id: 10, code: "BC", version: 4},
+	{id: 12, code: "RU", version: 5},
+}
+
+// CardCount is a card of a deck together with the number of copies
+type CardCount struct {
+	CardCode string
+	Count    int
+}
+
+// DecodeDeck returns the cards of the given deck code
+func DecodeDeck(code string) ([]CardCount, error) {
+	data, err := deckCodeEncoding.DecodeString(strings.ToUpper(strings.TrimRight(code, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDeckCode, err)
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%w: empty code", ErrInvalidDeckCode)
+	}
+	format, version := int(data[0]>>4), int(data[0]&0xF)
+	if format != deckCodeFormat || version > MaxDeckCodeVersion {
+		return nil, fmt.Errorf("%w: unsupported format %d version %d", ErrInvalidDeckCode, format, version)
+	}
+	d := &deckDecoder{data: data[1:]}
+	var res []CardCount
+	// cards with 3, 2 and 1 copies are grouped by set and faction
+	for count := 3; count >= 1; count-- {
+		res = append(res, d.groups(count)...)
+	}
+	// cards with more than 3 copies are listed individually
+	for len(d.data) > 0 && d.err == nil {
+		count := d.varint()
+		if card := d.card(d.varint(), d.varint(), d.varint()); d.err == nil {
+			res = append(res, CardCount{CardCode: card, Count: count})
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return res, nil
+}
+
+// EncodeDeck returns the deck code of the given cards. Cards listed more than once are combined.
+func EncodeDeck(cards []CardCount) (string, error) {
+	counts := map[string]int{}
+	var parsed []deckCard
+	version := 1
+	for _, card := range cards {
+		if card.Count < 1 {
+			return "", fmt.Errorf("%w: invalid count %d of card %s", ErrInvalidDeckCode, card.Count, card.CardCode)
+		}
+		if _, ok := counts[card.CardCode]; !ok {
+			c, err := parseCardCode(card.CardCode)
+			if err != nil {
+				return "", err
+			}
+			version = max(version, c.faction.version)
+			parsed = append(parsed, c)
+		}
+		counts[card.CardCode] += card.Count
+	}
+	byCount := map[int][]deckCard{}
+	var many []deckCard
+	for _, c := range parsed {
+		c.count = counts[c.code]
+		if c.count > 3 {
+			many = append(many, c)
+		} else {
+			byCount[c.count] = append(byCount[c.count], c)
+		}
+	}
+	buf := []byte{byte(deckCodeFormat<<4 | version)}
+	for count := 3; count >= 1; count-- {
+		buf = appendGroups(buf, byCount[count])
+	}
+	sortCards(many)
+	for _, c := range many {
+		buf = appendVarints(buf, c.count, c.set, c.faction.id, c.number)
+	}
+	return deckCodeEncoding.EncodeToString(buf), nil
+}
+
+// deckCard is a parsed card code
+type deckCard struct {
+	code    string
+	set     int
+	faction faction
+	number  int
+	count   int
+}
+
+func parseCardCode(code string) (deckCard, error) {
+	invalid := fmt.Errorf("%w: invalid card code %q", ErrInvalidDeckCode, code)
+	if len(code) != cardCodeLength {
+		return deckCard{}, invalid
+	}
+	set, err := strconv.Atoi(code[:2])
+	if err != nil {
+		return deckCard{}, invalid
+	}
+	number, err := strconv.Atoi(code[4:])
+	if err != nil {
+		return deckCard{}, invalid
+	}
+	for _, f := range factions {
+		if f.code == code[2:4] {
+			return deckCard{code: code, set: set, faction: f, number: number}, nil
+		}
+	}
+	return deckCard{}, invalid
+}
+
+// appendGroups appends the cards grouped by set and faction. Groups are ordered by size and code of their first
+// card, cards within a group by code.
+func appendGroups(buf []byte, cards []deckCard) []byte {
+	groups := map[[2]int][]deckCard{}
+	var keys [][2]int
+	for _, c := range cards {
+		key := [2]int{c.set, c.faction.id}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], c)
+	}
+	sorted := make([][]deckCard, 0, len(keys))
+	for _, key := range keys {
+		sortCards(groups[key])
+		sorted = append(sorted, groups[key])
+	}
+	sort.Slice(
+		sorted, func(i, j int) bool {
+			if len(sorted[i]) != len(sorted[j]) {
+				return len(sorted[i]) < len(sorted[j])
+			}
+			return sorted[i][0].code < sorted[j][0].code
+		},
+	)
+	buf = binary.AppendUvarint(buf, uint64(len(sorted)))
+	for _, group := range sorted {
+		buf = appendVarints(buf, len(group), group[0].set, group[0].faction.id)
+		for _, c := range group {
+			buf = binary.AppendUvarint(buf, uint64(c.number))
+		}
+	}
+	return buf
+}
+
+func appendVarints(buf []byte, values ...int) []byte {
+	for _, v := range values {
+		buf = binary.AppendUvarint(buf, uint64(v))
+	}
+	return buf
+}
+
+func sortCards(cards []deckCard) {
+	sort.Slice(
+		cards, func(i, j int) bool {
+			return cards[i].code < cards[j].code
+		},
+	)
+}
+
+// deckDecoder reads the varints of a deck code. The first error is kept and stops further reads.
+type deckDecoder struct {
+	data []byte
+	err  error
+}
+
+// groups reads the groups of cards with the given number of copies
+func (d *deckDecoder) groups(count int) []CardCount {
+	var res []CardCount
+	groups := d.varint()
+	for i := 0; i < groups && d.err == nil; i++ {
+		cards, set, factionID := d.varint(), d.varint(), d.varint()
+		for j := 0; j < cards && d.err == nil; j++ {
+			if card := d.card(set, factionID, d.varint()); d.err == nil {
+				res = append(res, CardCount{CardCode: card, Count: count})
+			}
+		}
+	}
+	return res
+}
+
+func (d *deckDecoder) varint() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = fmt.Errorf("%w: truncated code", ErrInvalidDeckCode)
+		return 0
+	}
+	d.data = d.data[n:]
+	return int(v)
+}
+
+func (d *deckDecoder) card(set, factionID, number int) string {
+	if d.err != nil {
+		return ""
+	}
+	for _, f := range factions {
+		if f.id == factionID {
+			return fmt.Sprintf("%02d%s%03d", set, f.code, number)
+		}
+	}
+	d.err = fmt.Errorf("%w: unknown faction %d", ErrInvalidDeckCode, factionID)
+	return ""
+}
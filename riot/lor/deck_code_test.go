@@ -0,0 +1,168 @@
+package lor
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sortedCardCounts(cards []CardCount) []CardCount {
+	res := append([]CardCount(nil), cards...)
+	sort.Slice(
+		res, func(i, j int) bool {
+			return res[i].CardCode < res[j].CardCode
+		},
+	)
+	return res
+}
+
+func TestDecodeDeck(t *testing.T) {
+	t.Parallel()
+	got, err := DecodeDeck("CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA")
+	require.NoError(t, err)
+	assert.Equal(
+		t, []CardCount{
+			{CardCode: "01SI015", Count: 3},
+			{CardCode: "01SI044", Count: 3},
+			{CardCode: "01SI048", Count: 3},
+			{CardCode: "01SI054", Count: 3},
+			{CardCode: "01FR003", Count: 3},
+			{CardCode: "01FR012", Count: 3},
+			{CardCode: "01FR020", Count: 3},
+			{CardCode: "01FR024", Count: 3},
+			{CardCode: "01FR033", Count: 3},
+			{CardCode: "01FR036", Count: 3},
+			{CardCode: "01FR039", Count: 3},
+			{CardCode: "01FR052", Count: 3},
+			{CardCode: "01SI005", Count: 2},
+			{CardCode: "01FR004", Count: 2},
+		}, got,
+	)
+}
+
+func TestDecodeDeck_Invalid(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		code string
+	}{
+		{name: "empty", code: ""},
+		{name: "invalid base32", code: "not a deck code!"},
+		{name: "unsupported format", code: deckCodeEncoding.EncodeToString([]byte{0x21, 0, 0, 0})},
+		{name: "unsupported version", code: deckCodeEncoding.EncodeToString([]byte{0x1F, 0, 0, 0})},
+		{name: "truncated", code: deckCodeEncoding.EncodeToString([]byte{0x11, 1, 2, 1})},
+		{name: "unknown faction", code: deckCodeEncoding.EncodeToString([]byte{0x11, 1, 1, 1, 8, 1, 0, 0})},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				t.Parallel()
+				_, err := DecodeDeck(tt.code)
+				assert.ErrorIs(t, err, ErrInvalidDeckCode)
+			},
+		)
+	}
+}
+
+func TestEncodeDeck(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name  string
+		cards []CardCount
+		want  string
+	}{
+		{
+			name:  "single card",
+			cards: []CardCount{{CardCode: "01DE001", Count: 3}},
+			want:  deckCodeEncoding.EncodeToString([]byte{0x11, 1, 1, 1, 0, 1, 0, 0}),
+		},
+		{
+			name: "version of newest faction",
+			cards: []CardCount{
+				{CardCode: "01DE001", Count: 1},
+				{CardCode: "05BC140", Count: 1},
+			},
+			want: deckCodeEncoding.EncodeToString([]byte{0x14, 0, 0, 2, 1, 1, 0, 1, 1, 5, 10, 140, 1}),
+		},
+		{
+			name: "more than three copies",
+			cards: []CardCount{
+				{CardCode: "01DE001", Count: 2},
+				{CardCode: "01DE001", Count: 2},
+			},
+			want: deckCodeEncoding.EncodeToString([]byte{0x11, 0, 0, 0, 4, 1, 0, 1}),
+		},
+		{
+			name: "canonical code",
+			cards: []CardCount{
+				{CardCode: "05BC140", Count: 3},
+				{CardCode: "01FR016", Count: 3},
+				{CardCode: "02FR003", Count: 3},
+				{CardCode: "01PZ020", Count: 3},
+				{CardCode: "03FR002", Count: 3},
+				{CardCode: "01FR053", Count: 3},
+				{CardCode: "04FR015", Count: 3},
+				{CardCode: "03PZ018", Count: 3},
+				{CardCode: "01PZ023", Count: 3},
+				{CardCode: "05BC160", Count: 3},
+				{CardCode: "03PZ019", Count: 3},
+				{CardCode: "01FR008", Count: 3},
+				{CardCode: "01FR057", Count: 2},
+				{CardCode: "01FR043", Count: 2},
+			},
+			want: "CQDQCAQBAMAQGAICAECACDYCAECBIFYCAMCBEEYCAUFIYANAAEBQCAIICA2QCAQBAEVTSAA",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				t.Parallel()
+				got, err := EncodeDeck(tt.cards)
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestEncodeDeck_RoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, code := range []string{
+		"CEBAIAIFB4WDANQIAEAQGDAUDAQSIJZUAIAQCBIFAEAQCBAA",
+		"CEAAECABAQJRWHBIFU2DOOYIAEBAMCIMCINCILJZAICACBANE4VCYBABAILR2HRL",
+	} {
+		cards, err := DecodeDeck(code)
+		require.NoError(t, err)
+		encoded, err := EncodeDeck(cards)
+		require.NoError(t, err)
+		decoded, err := DecodeDeck(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, sortedCardCounts(cards), sortedCardCounts(decoded))
+	}
+}
+
+func TestEncodeDeck_Invalid(t *testing.T) {
+	t.Parallel()
+	for _, cards := range [][]CardCount{
+		{{CardCode: "01DE001", Count: 0}},
+		{{CardCode: "01XX001", Count: 1}},
+		{{CardCode: "01DE01", Count: 1}},
+		{{CardCode: "AADE001", Count: 1}},
+	} {
+		_, err := EncodeDeck(cards)
+		assert.ErrorIs(t, err, ErrInvalidDeckCode)
+	}
+}
+
+func TestMatchPlayer_GetCards(t *testing.T) {
+	t.Parallel()
+	code, err := EncodeDeck([]CardCount{{CardCode: "01IO012", Count: 3}})
+	require.NoError(t, err)
+	got, err := (&MatchPlayer{DeckCode: code}).GetCards()
+	require.NoError(t, err)
+	assert.Equal(t, []CardCount{{CardCode: "01IO012", Count: 3}}, got)
+	_, err = (&Deck{Code: "!"}).GetCards()
+	assert.ErrorIs(t, err, ErrInvalidDeckCode)
+}
@@ -0,0 +1,32 @@
+package lor
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// InventoryClient provides methods for the inventory endpoints of the Legends of Runeterra API. All endpoints
+// require an access token of the player obtained through Riot Sign On.
+type InventoryClient struct {
+	c *internal.Client
+}
+
+// GetCards returns the cards owned by the player authorized by the given access token
+func (ic *InventoryClient) GetCards(accessToken string) ([]*Card, error) {
+	logger := ic.logger().WithField("method", "GetCards")
+	var out []*Card
+	c := ic.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err := c.GetInto(
+		endpointInventoryCards, &out, internal.WithHeader(authorizationHeaderName, "Bearer "+accessToken),
+	); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ic *InventoryClient) logger() log.FieldLogger {
+	return ic.c.Logger().WithField("category", "inventory")
+}
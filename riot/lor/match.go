@@ -0,0 +1,67 @@
+package lor
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/riot/account"
+)
+
+// MatchClient provides methods for the match endpoints of the Legends of Runeterra API.
+type MatchClient struct {
+	c *internal.Client
+	// shards is used to route player-scoped requests to the active shard of the player if set
+	shards *account.ShardCache
+}
+
+// GetMatchesByPUUID returns the IDs of the most recent matches of the player with the given PUUID
+func (mc *MatchClient) GetMatchesByPUUID(puuid string) ([]string, error) {
+	logger := mc.logger().WithField("method", "GetMatchesByPUUID")
+	var out []string
+	player, err := mc.ForPlayer(puuid)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	c := player.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err = c.GetInto(fmt.Sprintf(endpointMatchesByPUUID, puuid), &out); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMatchByID returns the match with the given ID. The match is requested from the region of the client, use
+// ForPlayer to request a match of a player on another shard.
+func (mc *MatchClient) GetMatchByID(matchID string) (*Match, error) {
+	logger := mc.logger().WithField("method", "GetMatchByID")
+	var out *Match
+	c := mc.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err := c.GetInto(fmt.Sprintf(endpointMatchByID, matchID), &out); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+// ForPlayer returns a client sending requests to the active shard of the player with the given PUUID, or the
+// client itself if active shards are not used. Use it to request matches of the player with GetMatchByID.
+func (mc *MatchClient) ForPlayer(puuid string) (*MatchClient, error) {
+	if mc.shards == nil {
+		return mc, nil
+	}
+	shard, err := mc.shards.ActiveShard(account.GameLoR, puuid)
+	if err != nil {
+		return nil, err
+	}
+	c := *mc.c
+	c.Region = shard
+	return &MatchClient{c: &c}, nil
+}
+
+func (mc *MatchClient) logger() log.FieldLogger {
+	return mc.c.Logger().WithField("category", "match")
+}
@@ -0,0 +1,97 @@
+package lor
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestMatchClient_GetMatchesByPUUID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    []string
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: []string{"match"},
+			doer: mock.NewJSONMockDoer([]string{"match"}, 200),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&MatchClient{c: client}).GetMatchesByPUUID("puuid")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestMatchClient_GetMatchByID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		want    *Match
+		doer    internal.Doer
+		wantErr error
+	}{
+		{
+			name: "get response",
+			want: &Match{},
+			doer: mock.NewJSONMockDoer(&Match{}, 200),
+		},
+		{
+			name:    "not found",
+			wantErr: api.ErrNotFound,
+			doer:    mock.NewStatusMockDoer(http.StatusNotFound),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
+				got, err := (&MatchClient{c: client}).GetMatchByID("match")
+				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				if tt.wantErr == nil {
+					assert.Equal(t, got, tt.want)
+				}
+			},
+		)
+	}
+}
+
+func TestMatchClient_RoutesToShard(t *testing.T) {
+	t.Parallel()
+	var host string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			host = r.URL.Host
+			return mock.NewJSONMockDoer([]string{}, 200).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionKorea, "API_KEY", doer, logrus.StandardLogger())
+	_, err := (&MatchClient{c: client}).GetMatchesByPUUID("puuid")
+	require.NoError(t, err)
+	assert.Equal(t, "sea.api.riotgames.com", host)
+	assert.Equal(t, api.RegionKorea, client.Region)
+}
@@ -6,3 +6,111 @@ type Player struct {
 	Rank         int    `json:"rank"`
 	LeaguePoints int    `json:"lp"`
 }
+
+// Match represents a Legends of Runeterra match
+type Match struct {
+	Metadata MatchMetadata `json:"metadata"`
+	Info     MatchInfo     `json:"info"`
+}
+
+// MatchMetadata contains the ID and participants of a match
+type MatchMetadata struct {
+	DataVersion string `json:"data_version"`
+	MatchID     string `json:"match_id"`
+	// Participants contains the PUUIDs of the players
+	Participants []string `json:"participants"`
+}
+
+// MatchInfo contains the details of a match
+type MatchInfo struct {
+	// (Legal values: Constructed, Expeditions, Tutorial)
+	GameMode string `json:"game_mode"`
+	// (Legal values: Ranked, Normal, AI, Tutorial, VanillaTrial, Singleton, StandardGauntlet)
+	GameType         string         `json:"game_type"`
+	GameStartTimeUTC string         `json:"game_start_time_utc"`
+	GameVersion      string         `json:"game_version"`
+	Players          []*MatchPlayer `json:"players"`
+	// Total turns taken by both players
+	TotalTurnCount int `json:"total_turn_count"`
+}
+
+// MatchPlayer contains the deck and result of a player in a match
+type MatchPlayer struct {
+	PUUID    string   `json:"puuid"`
+	DeckID   string   `json:"deck_id"`
+	DeckCode string   `json:"deck_code"`
+	Factions []string `json:"factions"`
+	// (Legal values: GameOutcomeWin, GameOutcomeLoss, GameOutcomeTie)
+	GameOutcome string `json:"game_outcome"`
+	// The order in which the players took turns, starting at 0
+	OrderOfPlay int `json:"order_of_play"`
+}
+
+// GetCards returns the cards of the deck played by the player
+func (p *MatchPlayer) GetCards() ([]CardCount, error) {
+	return DecodeDeck(p.DeckCode)
+}
+
+// Deck is a deck of a player
+type Deck struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// GetCards returns the cards of the deck
+func (d *Deck) GetCards() ([]CardCount, error) {
+	return DecodeDeck(d.Code)
+}
+
+// NewDeck contains the name and code of a deck to create
+type NewDeck struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+// Card is a card in the inventory of a player
+type Card struct {
+	Code string `json:"code"`
+	// Count is the number of copies owned, e.g. "3"
+	Count string `json:"count"`
+}
+
+// Content represents titles and translations fields of Update and Status
+type Content struct {
+	Locale  string `json:"locale"`
+	Content string `json:"content"`
+}
+
+// Update holds data of current software updates for the platforms
+type Update struct {
+	ID               int32      `json:"id"`
+	Author           string     `json:"author"`
+	Publish          bool       `json:"publish"`
+	PublishLocations []string   `json:"publish_locations"`
+	Translations     []*Content `json:"translations"`
+	CreatedAt        string     `json:"created_at"`
+	UpdatedAt        string     `json:"updated_at"`
+}
+
+// Status represents current maintenance and incidents
+type Status struct {
+	ID                int32      `json:"id"`
+	MaintenanceStatus string     `json:"maintenance_status"`
+	IncidentSeverity  string     `json:"incident_severity"`
+	Titles            []*Content `json:"titles"`
+	Updates           []*Update  `json:"updates"`
+	CreatedAt         string     `json:"created_at"`
+	ArchiveAt         string     `json:"archive_at"`
+	UpdatedAt         string     `json:"updated_at"`
+	Platforms         []string   `json:"platforms"`
+}
+
+// PlatformData represents Legends of Runeterra status for given platform
+type PlatformData struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Locales      []string  `json:"locales"`
+	Maintenances []*Status `json:"maintenances"`
+	Incidents    []*Status `json:"incidents"`
+}
@@ -1,6 +1,9 @@
 package lor
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
 
 // RankedClient provides methods for the ranked endpoints of the Legends of Runeterra API.
 type RankedClient struct {
@@ -10,7 +13,8 @@ type RankedClient struct {
 // GetMasters returns all players currently in the Master tier for the region.
 func (c *RankedClient) GetMasters() ([]*Player, error) {
 	var players []*Player
-	if err := c.c.GetInto(endpointGetMaster, &players); err != nil {
+	base := c.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err := base.GetInto(endpointGetMaster, &players); err != nil {
 		return nil, err
 	}
 	return players, nil
@@ -0,0 +1,29 @@
+package lor
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+// StatusClient provides methods for the status endpoints of the Legends of Runeterra API.
+type StatusClient struct {
+	c *internal.Client
+}
+
+// GetPlatformData returns information about platform including maintenances and incidents
+func (sc *StatusClient) GetPlatformData() (*PlatformData, error) {
+	logger := sc.logger().WithField("method", "GetPlatformData")
+	var out *PlatformData
+	c := sc.c.ForGame(api.GameLoR, api.FamilyPlatform)
+	if err := c.GetInto(endpointPlatformData, &out); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+func (sc *StatusClient) logger() log.FieldLogger {
+	return sc.c.Logger().WithField("category", "status")
+}
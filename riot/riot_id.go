@@ -0,0 +1,176 @@
+package riot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/KnutZuidema/golio/riot/account"
+)
+
+const (
+	// DefaultRiotIDTTL is the time a resolved Riot ID is cached by a resolver created by NewClient
+	DefaultRiotIDTTL = time.Hour
+	// maxConcurrentLookups limits the number of parallel account lookups in Resolver.ResolveMany
+	maxConcurrentLookups = 5
+)
+
+// ErrInvalidRiotID is returned if a string is not a Riot ID of the form GameName#TagLine
+var ErrInvalidRiotID = errors.New("invalid riot id, expected GameName#TagLine")
+
+// RiotID is the user facing identifier of a Riot account
+type RiotID struct {
+	GameName string
+	TagLine  string
+}
+
+// ParseRiotID parses a Riot ID of the form GameName#TagLine. Since game names may not contain '#', the
+// string is split at the last '#'.
+func ParseRiotID(s string) (RiotID, error) {
+	i := strings.LastIndex(s, "#")
+	if i < 0 {
+		return RiotID{}, ErrInvalidRiotID
+	}
+	id := RiotID{
+		GameName: strings.TrimSpace(s[:i]),
+		TagLine:  strings.TrimSpace(s[i+1:]),
+	}
+	if id.GameName == "" || id.TagLine == "" {
+		return RiotID{}, ErrInvalidRiotID
+	}
+	return id, nil
+}
+
+// String returns the Riot ID in the form GameName#TagLine
+func (id RiotID) String() string {
+	return id.GameName + "#" + id.TagLine
+}
+
+// key returns the cache key of the Riot ID. Riot IDs are case-insensitive.
+func (id RiotID) key() string {
+	return strings.ToLower(id.String())
+}
+
+type resolverEntry struct {
+	account account.Account
+	expires time.Time
+}
+
+// Resolver resolves Riot IDs to PUUIDs and back using the account endpoints and caches the results
+type Resolver struct {
+	client   *account.Client
+	ttl      time.Duration
+	mu       sync.RWMutex
+	byRiotID map[string]resolverEntry
+	byPUUID  map[string]resolverEntry
+	now      func() time.Time
+}
+
+// NewResolver returns a new resolver using the given account client which caches accounts for the given duration
+func NewResolver(client *account.Client, ttl time.Duration) *Resolver {
+	return &Resolver{
+		client:   client,
+		ttl:      ttl,
+		byRiotID: map[string]resolverEntry{},
+		byPUUID:  map[string]resolverEntry{},
+		now:      time.Now,
+	}
+}
+
+// Resolve returns the account for a Riot ID of the form GameName#TagLine
+func (r *Resolver) Resolve(riotID string) (*account.Account, error) {
+	id, err := ParseRiotID(riotID)
+	if err != nil {
+		return nil, err
+	}
+	if acc, ok := r.cached(r.byRiotID, id.key()); ok {
+		return acc, nil
+	}
+	acc, err := r.client.GetByRiotID(id.GameName, id.TagLine)
+	if err != nil {
+		return nil, err
+	}
+	r.store(*acc, id.key())
+	return acc, nil
+}
+
+// PUUID returns the PUUID for a Riot ID of the form GameName#TagLine
+func (r *Resolver) PUUID(riotID string) (string, error) {
+	acc, err := r.Resolve(riotID)
+	if err != nil {
+		return "", err
+	}
+	return acc.Puuid, nil
+}
+
+// RiotID returns the current Riot ID of the account with the given PUUID
+func (r *Resolver) RiotID(puuid string) (RiotID, error) {
+	acc, ok := r.cached(r.byPUUID, puuid)
+	if !ok {
+		var err error
+		acc, err = r.client.GetByPUUID(puuid)
+		if err != nil {
+			return RiotID{}, err
+		}
+		r.store(*acc, "")
+	}
+	return RiotID{GameName: acc.GameName, TagLine: acc.TagLine}, nil
+}
+
+// ResolveMany resolves all given Riot IDs in parallel and returns the accounts keyed by the given Riot IDs.
+// If some lookups fail, the successfully resolved accounts are returned together with the joined errors.
+func (r *Resolver) ResolveMany(riotIDs ...string) (map[string]*account.Account, error) {
+	res := make(map[string]*account.Account, len(riotIDs))
+	var errs []error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentLookups)
+	for _, riotID := range riotIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(riotID string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			acc, err := r.Resolve(riotID)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, fmt.Errorf("resolve %s: %w", riotID, err))
+				return
+			}
+			res[riotID] = acc
+		}(riotID)
+	}
+	wg.Wait()
+	return res, errors.Join(errs...)
+}
+
+func (r *Resolver) cached(cache map[string]resolverEntry, key string) (*account.Account, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	entry, ok := cache[key]
+	if !ok || !r.now().Before(entry.expires) {
+		return nil, false
+	}
+	acc := entry.account
+	return &acc, true
+}
+
+// store caches the account by its PUUID and Riot ID. The key used for the lookup is cached as well, since the
+// returned Riot ID may differ in case from the requested one.
+func (r *Resolver) store(acc account.Account, requestedKey string) {
+	entry := resolverEntry{account: acc, expires: r.now().Add(r.ttl)}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.byPUUID[acc.Puuid] = entry
+	if acc.GameName != "" && acc.TagLine != "" {
+		r.byRiotID[RiotID{GameName: acc.GameName, TagLine: acc.TagLine}.key()] = entry
+	}
+	if requestedKey != "" {
+		r.byRiotID[requestedKey] = entry
+	}
+}
@@ -0,0 +1,180 @@
+package riot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/riot/account"
+)
+
+func TestParseRiotID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		in      string
+		want    RiotID
+		wantErr error
+	}{
+		{
+			name: "valid",
+			in:   "Faker#KR1",
+			want: RiotID{GameName: "Faker", TagLine: "KR1"},
+		},
+		{
+			name: "spaces",
+			in:   " Some Name # EUW ",
+			want: RiotID{GameName: "Some Name", TagLine: "EUW"},
+		},
+		{
+			name:    "no tag",
+			in:      "Faker",
+			wantErr: ErrInvalidRiotID,
+		},
+		{
+			name:    "empty tag",
+			in:      "Faker#",
+			wantErr: ErrInvalidRiotID,
+		},
+		{
+			name:    "empty name",
+			in:      "#KR1",
+			wantErr: ErrInvalidRiotID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				got, err := ParseRiotID(tt.in)
+				require.Equal(t, tt.wantErr, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+	assert.Equal(t, "Faker#KR1", RiotID{GameName: "Faker", TagLine: "KR1"}.String())
+}
+
+// fakeAccountServer serves the account endpoints for the given accounts and the active shard endpoint, which
+// always returns the given shard. All requested paths are recorded.
+type fakeAccountServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []string
+}
+
+func newFakeServer(t *testing.T, shard string, accounts ...account.Account) *fakeAccountServer {
+	s := &fakeAccountServer{}
+	s.Server = httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				s.mu.Lock()
+				s.requests = append(s.requests, r.URL.Path)
+				s.mu.Unlock()
+				parts := strings.Split(r.URL.Path, "/")
+				isAccount := strings.Contains(r.URL.Path, "/riot/account/")
+				for _, acc := range accounts {
+					if !isAccount {
+						break
+					}
+					switch {
+					case strings.Contains(r.URL.Path, "/active-shards/") && parts[len(parts)-1] == acc.Puuid:
+						_ = json.NewEncoder(w).Encode(account.ActiveShard{Puuid: acc.Puuid, ActiveShard: shard})
+						return
+					case strings.Contains(r.URL.Path, "/by-riot-id/") &&
+						strings.EqualFold(parts[len(parts)-2], acc.GameName) &&
+						strings.EqualFold(parts[len(parts)-1], acc.TagLine),
+						strings.Contains(r.URL.Path, "/by-puuid/") && parts[len(parts)-1] == acc.Puuid:
+						_ = json.NewEncoder(w).Encode(acc)
+						return
+					}
+				}
+				if isAccount {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if strings.Contains(r.URL.Path, "/summoners/") {
+					_, _ = w.Write([]byte("{}"))
+					return
+				}
+				_, _ = w.Write([]byte("null"))
+			},
+		),
+	)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeAccountServer) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *fakeAccountServer) client() *Client {
+	return NewClient(
+		api.RegionEuropeWest, "API_KEY", http.DefaultClient, log.StandardLogger(),
+		WithBaseURL(s.URL+"/{region}"),
+	)
+}
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+	faker := account.Account{Puuid: "puuid-1", GameName: "Faker", TagLine: "KR1"}
+	server := newFakeServer(t, "", faker)
+	r := server.client().Resolver
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	got, err := r.Resolve("faker#kr1")
+	require.NoError(t, err)
+	assert.Equal(t, &faker, got)
+	puuid, err := r.PUUID("Faker#KR1")
+	require.NoError(t, err)
+	assert.Equal(t, "puuid-1", puuid)
+	id, err := r.RiotID("puuid-1")
+	require.NoError(t, err)
+	assert.Equal(t, RiotID{GameName: "Faker", TagLine: "KR1"}, id)
+	assert.Len(t, server.Requests(), 1)
+
+	now = now.Add(DefaultRiotIDTTL)
+	_, err = r.PUUID("Faker#KR1")
+	require.NoError(t, err)
+	assert.Len(t, server.Requests(), 2)
+
+	_, err = r.Resolve("Faker")
+	assert.Equal(t, ErrInvalidRiotID, err)
+	_, err = r.Resolve("Unknown#NA1")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = r.RiotID("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestResolver_ResolveMany(t *testing.T) {
+	t.Parallel()
+	accounts := []account.Account{
+		{Puuid: "puuid-1", GameName: "A", TagLine: "1"},
+		{Puuid: "puuid-2", GameName: "B", TagLine: "2"},
+		{Puuid: "puuid-3", GameName: "C", TagLine: "3"},
+	}
+	server := newFakeServer(t, "", accounts...)
+	got, err := server.client().Resolver.ResolveMany("A#1", "B#2", "C#3", "D#4", "invalid")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	assert.ErrorIs(t, err, ErrInvalidRiotID)
+	assert.Equal(
+		t, map[string]*account.Account{
+			"A#1": &accounts[0],
+			"B#2": &accounts[1],
+			"C#3": &accounts[2],
+		}, got,
+	)
+}
@@ -0,0 +1,227 @@
+package tft
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CarryMinItems is the minimum number of items a unit has to hold to count as a carry
+const CarryMinItems = 2
+
+// top4Placement is the worst placement which counts as a top 4 finish
+const top4Placement = 4
+
+// top2TeamPlacement is the worst team placement which counts as a top finish in Double Up
+const top2TeamPlacement = 2
+
+// ActiveTrait is a trait activated on the board of a participant
+type ActiveTrait struct {
+	Name     string
+	NumUnits int64
+	Style    int64
+}
+
+// BoardUnit is a unit on the board of a participant
+type BoardUnit struct {
+	CharacterID string
+	// Stars is the star level of the unit
+	Stars int
+	Items []string
+}
+
+// Composition describes the board of a participant at the time they were eliminated
+type Composition struct {
+	Level int64
+	// Traits contains the active traits ordered by style, number of units and name
+	Traits []ActiveTrait
+	// Units contains all units ordered by number of items, star level and character ID
+	Units []BoardUnit
+}
+
+// GetComposition returns the composition of the participant's board
+func (p *Participant) GetComposition() Composition {
+	res := Composition{Level: p.Level}
+	for _, trait := range p.Traits {
+		if trait.Style > TraitStyleNone {
+			res.Traits = append(res.Traits, ActiveTrait{Name: trait.Name, NumUnits: trait.NumUnits, Style: trait.Style})
+		}
+	}
+	sort.Slice(
+		res.Traits, func(i, j int) bool {
+			a, b := res.Traits[i], res.Traits[j]
+			if a.Style != b.Style {
+				return a.Style > b.Style
+			}
+			if a.NumUnits != b.NumUnits {
+				return a.NumUnits > b.NumUnits
+			}
+			return a.Name < b.Name
+		},
+	)
+	for i := range p.Units {
+		unit := &p.Units[i]
+		res.Units = append(
+			res.Units, BoardUnit{CharacterID: unit.CharacterID, Stars: unit.Tier, Items: unit.GetItemNames()},
+		)
+	}
+	sort.SliceStable(
+		res.Units, func(i, j int) bool {
+			a, b := res.Units[i], res.Units[j]
+			if len(a.Items) != len(b.Items) {
+				return len(a.Items) > len(b.Items)
+			}
+			if a.Stars != b.Stars {
+				return a.Stars > b.Stars
+			}
+			return a.CharacterID < b.CharacterID
+		},
+	)
+	return res
+}
+
+// Carries returns the units holding at least CarryMinItems items
+func (c *Composition) Carries() []BoardUnit {
+	var res []BoardUnit
+	for _, unit := range c.Units {
+		if len(unit.Items) >= CarryMinItems {
+			res = append(res, unit)
+		}
+	}
+	return res
+}
+
+// Signature returns a key identifying the composition, built from the active traits with their styles and the
+// carries with their star levels, e.g. "Set9_Sorcerer:3,Set9_Ionia:1|TFT9_Ahri*2"
+func (c *Composition) Signature() string {
+	traits := make([]string, 0, len(c.Traits))
+	for _, trait := range c.Traits {
+		traits = append(traits, fmt.Sprintf("%s:%d", trait.Name, trait.Style))
+	}
+	carries := c.Carries()
+	units := make([]string, 0, len(carries))
+	for _, unit := range carries {
+		units = append(units, fmt.Sprintf("%s*%d", unit.CharacterID, unit.Stars))
+	}
+	return strings.Join(traits, ",") + "|" + strings.Join(units, ",")
+}
+
+// PlacementStats aggregates the placements of the boards sharing a composition, unit, item or trait. In Double Up
+// the placement of the team from 1 to 4 is used and a top 2 finish of the team counts as a top 4 finish.
+type PlacementStats struct {
+	Games        int
+	PlacementSum int64
+	Top4         int
+	Wins         int
+}
+
+// AveragePlacement returns the average placement or 0 if no games were played
+func (s PlacementStats) AveragePlacement() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.PlacementSum) / float64(s.Games)
+}
+
+// Top4Rate returns the fraction of games finished in the top 4 between 0 and 1
+func (s PlacementStats) Top4Rate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Top4) / float64(s.Games)
+}
+
+// WinRate returns the fraction of games finished in first place between 0 and 1
+func (s PlacementStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+	return float64(s.Wins) / float64(s.Games)
+}
+
+func (s *PlacementStats) add(placement, top int64) {
+	s.Games++
+	s.PlacementSum += placement
+	if placement <= top {
+		s.Top4++
+	}
+	if placement == 1 {
+		s.Wins++
+	}
+}
+
+// MatchStats contains the placement stats of many matches. Each board counts at most once per composition,
+// unit, item and trait.
+type MatchStats struct {
+	// Compositions contains the stats by composition signature
+	Compositions map[string]PlacementStats
+	// Units contains the stats by character ID
+	Units map[string]PlacementStats
+	// Items contains the stats by item name
+	Items map[string]PlacementStats
+	// Traits contains the stats of active traits by name
+	Traits map[string]PlacementStats
+}
+
+// AggregateMatches returns the placement stats of all participants of the given matches
+func AggregateMatches(matches []*Match) *MatchStats {
+	res := &MatchStats{
+		Compositions: map[string]PlacementStats{},
+		Units:        map[string]PlacementStats{},
+		Items:        map[string]PlacementStats{},
+		Traits:       map[string]PlacementStats{},
+	}
+	for _, match := range matches {
+		res.Add(match)
+	}
+	return res
+}
+
+// Add adds the placements of all participants of the match. Participants without a placement are skipped.
+// The team placements are used for Double Up matches.
+func (s *MatchStats) Add(match *Match) {
+	doubleUp := match.Info.IsDoubleUp()
+	for i := range match.Info.Participants {
+		participant := &match.Info.Participants[i]
+		if participant.Placement < 1 {
+			continue
+		}
+		placement := placementStat{placement: participant.Placement, top: top4Placement}
+		if doubleUp {
+			placement = placementStat{placement: participant.TeamPlacement(), top: top2TeamPlacement}
+		}
+		composition := participant.GetComposition()
+		addPlacement(s.Compositions, placement, composition.Signature())
+		var units, items, traits []string
+		for _, unit := range composition.Units {
+			units = append(units, unit.CharacterID)
+			items = append(items, unit.Items...)
+		}
+		for _, trait := range composition.Traits {
+			traits = append(traits, trait.Name)
+		}
+		addPlacement(s.Units, placement, units...)
+		addPlacement(s.Items, placement, items...)
+		addPlacement(s.Traits, placement, traits...)
+	}
+}
+
+// placementStat is a placement together with the worst placement counting as a top finish
+type placementStat struct {
+	placement int64
+	top       int64
+}
+
+// addPlacement adds the placement to the stats of each distinct key
+func addPlacement(stats map[string]PlacementStats, placement placementStat, keys ...string) {
+	added := map[string]bool{}
+	for _, key := range keys {
+		if added[key] {
+			continue
+		}
+		added[key] = true
+		s := stats[key]
+		s.add(placement.placement, placement.top)
+		stats[key] = s
+	}
+}
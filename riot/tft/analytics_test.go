@@ -0,0 +1,111 @@
+package tft
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testParticipant(placement int64, traits []Trait, units ...Unit) Participant {
+	return Participant{Placement: placement, Level: 8, Traits: traits, Units: units}
+}
+
+var (
+	testSorcererBoard = testParticipant(
+		1,
+		[]Trait{
+			{Name: "Set9_Ionia", NumUnits: 3, Style: TraitStyleBronze},
+			{Name: "Set9_Sorcerer", NumUnits: 4, Style: TraitStyleGold},
+			{Name: "Set9_Void", NumUnits: 1, Style: TraitStyleNone},
+		},
+		Unit{CharacterID: "TFT9_Ahri", Tier: 2, Items: []string{"1", "2", "3"}},
+		Unit{CharacterID: "TFT9_Lux", Tier: 2, Items: []string{"4"}},
+		Unit{CharacterID: "TFT9_Zoe", Tier: 3, Items: []string{"5", "5"}},
+		Unit{CharacterID: "TFT9_Irelia", Tier: 1},
+	)
+	testBruiserBoard = testParticipant(
+		6,
+		[]Trait{{Name: "Set9_Bruiser", NumUnits: 4, Style: TraitStyleSilver}},
+		Unit{CharacterID: "TFT9_Sett", Tier: 2, Items: []string{"6", "7"}},
+		Unit{CharacterID: "TFT9_Ahri", Tier: 1},
+	)
+)
+
+func TestParticipant_GetComposition(t *testing.T) {
+	t.Parallel()
+	got := testSorcererBoard.GetComposition()
+	assert.Equal(t, int64(8), got.Level)
+	assert.Equal(
+		t, []ActiveTrait{
+			{Name: "Set9_Sorcerer", NumUnits: 4, Style: TraitStyleGold},
+			{Name: "Set9_Ionia", NumUnits: 3, Style: TraitStyleBronze},
+		}, got.Traits,
+	)
+	units := make([]string, 0, len(got.Units))
+	for _, unit := range got.Units {
+		units = append(units, unit.CharacterID)
+	}
+	assert.Equal(t, []string{"TFT9_Ahri", "TFT9_Zoe", "TFT9_Lux", "TFT9_Irelia"}, units)
+	assert.Equal(
+		t, []BoardUnit{
+			{CharacterID: "TFT9_Ahri", Stars: 2, Items: []string{"1", "2", "3"}},
+			{CharacterID: "TFT9_Zoe", Stars: 3, Items: []string{"5", "5"}},
+		}, got.Carries(),
+	)
+	assert.Equal(t, "Set9_Sorcerer:3,Set9_Ionia:1|TFT9_Ahri*2,TFT9_Zoe*3", got.Signature())
+}
+
+func TestPlacementStats(t *testing.T) {
+	t.Parallel()
+	var stats PlacementStats
+	assert.Zero(t, stats.AveragePlacement())
+	assert.Zero(t, stats.Top4Rate())
+	assert.Zero(t, stats.WinRate())
+	for _, placement := range []int64{1, 4, 5, 8} {
+		stats.add(placement, top4Placement)
+	}
+	assert.Equal(t, PlacementStats{Games: 4, PlacementSum: 18, Top4: 2, Wins: 1}, stats)
+	assert.InDelta(t, 4.5, stats.AveragePlacement(), 1e-9)
+	assert.InDelta(t, 0.5, stats.Top4Rate(), 1e-9)
+	assert.InDelta(t, 0.25, stats.WinRate(), 1e-9)
+}
+
+func TestAggregateMatches(t *testing.T) {
+	t.Parallel()
+	secondSorcererBoard := testSorcererBoard
+	secondSorcererBoard.Placement = 3
+	matches := []*Match{
+		{Info: MatchInfo{Participants: []Participant{testSorcererBoard, testBruiserBoard}}},
+		{Info: MatchInfo{Participants: []Participant{secondSorcererBoard, {Placement: 0}}}},
+	}
+	got := AggregateMatches(matches)
+	signature := testSorcererBoard.GetComposition()
+	assert.Equal(
+		t, map[string]PlacementStats{
+			signature.Signature():        {Games: 2, PlacementSum: 4, Top4: 2, Wins: 1},
+			"Set9_Bruiser:2|TFT9_Sett*2": {Games: 1, PlacementSum: 6},
+		}, got.Compositions,
+	)
+	require.Contains(t, got.Units, "TFT9_Ahri")
+	assert.Equal(t, PlacementStats{Games: 3, PlacementSum: 10, Top4: 2, Wins: 1}, got.Units["TFT9_Ahri"])
+	// an item held twice by the same board is counted once
+	assert.Equal(t, PlacementStats{Games: 2, PlacementSum: 4, Top4: 2, Wins: 1}, got.Items["5"])
+	assert.Equal(t, PlacementStats{Games: 1, PlacementSum: 6}, got.Items["6"])
+	assert.Equal(t, PlacementStats{Games: 2, PlacementSum: 4, Top4: 2, Wins: 1}, got.Traits["Set9_Sorcerer"])
+	assert.NotContains(t, got.Traits, "Set9_Void")
+}
+
+func TestAggregateMatches_DoubleUp(t *testing.T) {
+	t.Parallel()
+	first, second, third := testSorcererBoard, testBruiserBoard, testSorcererBoard
+	first.Placement, first.PartnerGroupID = 2, 1
+	second.Placement, second.PartnerGroupID = 4, 2
+	third.Placement, third.PartnerGroupID = 6, 3
+	got := AggregateMatches(
+		[]*Match{{Info: MatchInfo{TFTGameType: GameTypePairs, Participants: []Participant{first, second, third}}}},
+	)
+	assert.Equal(t, PlacementStats{Games: 3, PlacementSum: 6, Top4: 2, Wins: 1}, got.Units["TFT9_Ahri"])
+	assert.Equal(t, PlacementStats{Games: 2, PlacementSum: 4, Top4: 1, Wins: 1}, got.Traits["Set9_Sorcerer"])
+	assert.Equal(t, PlacementStats{Games: 1, PlacementSum: 2, Top4: 1}, got.Traits["Set9_Bruiser"])
+}
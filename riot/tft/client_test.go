@@ -1,9 +1,12 @@
 package tft
 
 import (
+	"net/http"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
@@ -18,3 +21,28 @@ func TestNewClient(t *testing.T) {
 		t.Error("returned nil")
 	}
 }
+
+func TestClient_Routing(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			hosts = append(hosts, r.URL.Host)
+			return mock.NewJSONMockDoer(nil, http.StatusOK).Do(r)
+		},
+	}
+	c := NewClient(internal.NewClient(api.RegionKorea, "key", doer, log.StandardLogger()))
+	_, err := c.Summoner.GetSummonerByPUUID("puuid")
+	require.NoError(t, err)
+	_, err = c.League.GetEntriesByPUUID("puuid")
+	require.NoError(t, err)
+	_, err = c.Spectator.GetFeaturedGames()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kr.api.riotgames.com", "kr.api.riotgames.com", "kr.api.riotgames.com"}, hosts)
+
+	// platform endpoints can not be served by a VALORANT shard
+	c = NewClient(internal.NewClient(api.ValShardEurope, "key", doer, log.StandardLogger()))
+	_, err = c.Summoner.GetSummonerByPUUID("puuid")
+	assert.ErrorIs(t, err, api.ErrUnknownRegion)
+	assert.Len(t, hosts, 3)
+}
@@ -10,6 +10,7 @@ const (
 	endpointLeagueBase                = endpointBase + "/league/v1"
 	endpointLeagueChallenger          = endpointLeagueBase + "/challenger?queue=%s"
 	endpointLeagueEntriesBySummoner   = endpointLeagueBase + "/entries/by-summoner/%s"
+	endpointLeagueEntriesByPUUID      = endpointLeagueBase + "/by-puuid/%s"
 	endpointLeagueEntries             = endpointLeagueBase + "/entries/%s/%s"
 	endpointLeagueGrandMaster         = endpointLeagueBase + "/grandmaster?queue=%s"
 	endpointLeagueLeagues             = endpointLeagueBase + "/leagues/%s"
@@ -38,6 +39,15 @@ const (
 	QueueRankedTFTTurbo    queue = "RANKED_TFT_TURBO"
 )
 
+// Game types of a match in MatchInfo.TFTGameType
+const (
+	GameTypeStandard = "standard"
+	// GameTypePairs is the game type of Double Up
+	GameTypePairs = "pairs"
+	// GameTypeTurbo is the game type of Hyper Roll
+	GameTypeTurbo = "turbo"
+)
+
 type tier string
 
 // All possible Tiers
@@ -54,6 +64,17 @@ const (
 	TierChallenger  tier = "CHALLENGER"
 )
 
+type ratedTier string
+
+// All possible rated tiers of the Hyper Roll ladder, from lowest to highest
+const (
+	RatedTierGray   ratedTier = "GRAY"
+	RatedTierGreen  ratedTier = "GREEN"
+	RatedTierBlue   ratedTier = "BLUE"
+	RatedTierPurple ratedTier = "PURPLE"
+	RatedTierOrange ratedTier = "ORANGE"
+)
+
 type division string
 
 // All possible divisions
@@ -63,3 +84,12 @@ const (
 	DivisionThree division = "III"
 	DivisionFour  division = "IV"
 )
+
+// All possible styles of an active trait in Trait.Style. Inactive traits have TraitStyleNone.
+const (
+	TraitStyleNone      int64 = 0
+	TraitStyleBronze    int64 = 1
+	TraitStyleSilver    int64 = 2
+	TraitStyleGold      int64 = 3
+	TraitStyleChromatic int64 = 4
+)
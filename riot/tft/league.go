@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,12 +17,13 @@ type LeagueClient struct {
 // GetChallenger returns the current Challenger league for the Region
 func (lc *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
 	logger := lc.logger().WithField("method", "GetChallenger")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	if queue == "" {
 		queue = QueueRankedTFT
 	}
 	url := fmt.Sprintf(endpointLeagueChallenger, queue)
 	var out *LeagueList
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -31,9 +33,23 @@ func (lc *LeagueClient) GetChallenger(queue queue) (*LeagueList, error) {
 // GetEntriesBySummoner returns league entries for a given summoner ID
 func (lc *LeagueClient) GetEntriesBySummoner(summonerID string) ([]*LeagueEntry, error) {
 	logger := lc.logger().WithField("method", "GetEntriesBySummoner")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointLeagueEntriesBySummoner, summonerID)
 	var out []*LeagueEntry
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetEntriesByPUUID returns league entries for a given PUUID
+func (lc *LeagueClient) GetEntriesByPUUID(puuid string) ([]*LeagueEntry, error) {
+	logger := lc.logger().WithField("method", "GetEntriesByPUUID")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
+	url := fmt.Sprintf(endpointLeagueEntriesByPUUID, puuid)
+	var out []*LeagueEntry
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -43,9 +59,10 @@ func (lc *LeagueClient) GetEntriesBySummoner(summonerID string) ([]*LeagueEntry,
 // GetEntries returns all the league entries
 func (lc *LeagueClient) GetEntries(tier tier, division division) ([]*LeagueEntry, error) {
 	logger := lc.logger().WithField("method", "GetEntries")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointLeagueEntries, tier, division)
 	var out []*LeagueEntry
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -55,12 +72,13 @@ func (lc *LeagueClient) GetEntries(tier tier, division division) ([]*LeagueEntry
 // GetGrandMaster returns the current GrandMaster league for the Region
 func (lc *LeagueClient) GetGrandMaster(queue queue) (*LeagueList, error) {
 	logger := lc.logger().WithField("method", "GetGrandMaster")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	if queue == "" {
 		queue = QueueRankedTFT
 	}
 	url := fmt.Sprintf(endpointLeagueGrandMaster, queue)
 	var out *LeagueList
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -70,9 +88,10 @@ func (lc *LeagueClient) GetGrandMaster(queue queue) (*LeagueList, error) {
 // GetLeagues returns league with given ID, including inactive entries
 func (lc *LeagueClient) GetLeagues(leagueID string) (*LeagueList, error) {
 	logger := lc.logger().WithField("method", "GetLeagues")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointLeagueLeagues, leagueID)
 	var out *LeagueList
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -82,12 +101,13 @@ func (lc *LeagueClient) GetLeagues(leagueID string) (*LeagueList, error) {
 // GetMaster returns the current Master league for the Region
 func (lc *LeagueClient) GetMaster(queue queue) (*LeagueList, error) {
 	logger := lc.logger().WithField("method", "GetMaster")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	if queue == "" {
 		queue = QueueRankedTFT
 	}
 	url := fmt.Sprintf(endpointLeagueMaster, queue)
 	var out *LeagueList
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -97,9 +117,10 @@ func (lc *LeagueClient) GetMaster(queue queue) (*LeagueList, error) {
 // GetRatedLaddersByQueue returns the top rated ladder for given queue
 func (lc *LeagueClient) GetRatedLaddersByQueue(queue queue) ([]*TopRatedLadderEntry, error) {
 	logger := lc.logger().WithField("method", "GetRatedLaddersByQueue")
+	c := lc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointLeagueRatedLattersByQueue, queue)
 	var out []*TopRatedLadderEntry
-	if err := lc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
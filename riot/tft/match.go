@@ -17,10 +17,10 @@ type MatchClient struct {
 // GetMatchesByPUUID returns a list of match ids by PUUID
 func (mc *MatchClient) GetMatchesByPUUID(puuid string) ([]string, error) {
 	logger := mc.logger().WithField("method", "GetMatchesByPUUID")
-	mc.c.Region = api.Region(api.RegionToRoute[mc.c.Region])
+	c := mc.c.ForGame(api.GameTFT, api.FamilyRegional)
 	url := fmt.Sprintf(endpointMatchesByPUUID, puuid)
 	var out []string
-	if err := mc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -30,10 +30,10 @@ func (mc *MatchClient) GetMatchesByPUUID(puuid string) ([]string, error) {
 // GetMatchByMatchID returns a match by matchID
 func (mc *MatchClient) GetMatchByMatchID(matchId string) (*Match, error) {
 	logger := mc.logger().WithField("method", "GetMatchByMatchID")
-	mc.c.Region = api.Region(api.RegionToRoute[mc.c.Region])
+	c := mc.c.ForGame(api.GameTFT, api.FamilyRegional)
 	url := fmt.Sprintf(endpointMatchByMatchID, matchId)
 	var out *Match
-	if err := mc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -39,6 +39,7 @@ func TestTFTMatch_GetMatchesByPUUID(t *testing.T) {
 				client := internal.NewClient(api.RegionEuropeWest, "API_KEY", tt.doer, logrus.StandardLogger())
 				got, err := (&MatchClient{c: client}).GetMatchesByPUUID("puuid")
 				require.Equal(t, err, tt.wantErr, fmt.Sprintf("want err %v, got %v", tt.wantErr, err))
+				assert.Equal(t, api.RegionEuropeWest, client.Region)
 				if tt.wantErr == nil {
 					assert.Equal(t, got, tt.want)
 				}
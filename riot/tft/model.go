@@ -1,5 +1,14 @@
 package tft
 
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/KnutZuidema/golio/communitydragon"
+	"github.com/KnutZuidema/golio/datadragon"
+)
+
 // CurrentGameInfo contains current game information
 type CurrentGameInfo struct {
 	// The ID of the game
@@ -134,6 +143,8 @@ type LeagueList struct {
 }
 
 type LeagueItem struct {
+	// Player Universal Unique Identifier. Exact length of 78 characters. (Encrypted)
+	PUUID string `json:"puuid"`
 	// Player's encrypted summonerId
 	SummonerID   string `json:"summonerId"`
 	LeaguePoints int    `json:"leaguePoints"`
@@ -190,7 +201,14 @@ type LeagueEntry struct {
 	MiniSeries []MiniSerie `json:"miniSeries"`
 }
 
+// IsHyperRoll returns whether the entry is part of the Hyper Roll ladder, which uses rated tiers instead of tiers
+func (e *LeagueEntry) IsHyperRoll() bool {
+	return e.QueueType == string(QueueRankedTFTTurbo)
+}
+
 type TopRatedLadderEntry struct {
+	// Player Universal Unique Identifier. Exact length of 78 characters. (Encrypted)
+	PUUID string `json:"puuid"`
 	// Player's encrypted summonerId.
 	SummonerID string `json:"summonerId"`
 	// (Legal values: ORANGE, PURPLE, BLUE, GREEN, GRAY)
@@ -209,6 +227,11 @@ type Match struct {
 }
 
 type MatchInfo struct {
+	// Result of the game, e.g. "GameComplete"
+	EndOfGameResult string `json:"endOfGameResult"`
+	// Unix timestamp of the creation of the game in milliseconds
+	GameCreation int64 `json:"gameCreation"`
+	GameID       int64 `json:"gameId"`
 	// Unix timestamp
 	GameDatetime int64 `json:"game_datetime"`
 	// Game length in seconds
@@ -217,11 +240,31 @@ type MatchInfo struct {
 	GameVariation string `json:"game_variation"`
 	// Game client version
 	GameVersion  string        `json:"game_version"`
+	MapID        int64         `json:"mapId"`
 	Participants []Participant `json:"participants"`
 	// Please refer to the League of Legends documentation
 	QueueID int64 `json:"queueId"`
+	// Queue ID of older matches, which do not include QueueID. Use GetQueueID to get the queue of any match.
+	LegacyQueueID int64 `json:"queue_id"`
 	// Teamfight Tactics set number
 	TFTSetNumber int64 `json:"tft_set_number"`
+	// Teamfight Tactics game type, e.g. GameTypePairs for Double Up
+	TFTGameType string `json:"tft_game_type"`
+	// Name of the core set, e.g. "TFTSet9_Stage2" for a mid-set update
+	TFTSetCoreName string `json:"tft_set_core_name"`
+}
+
+// GetQueueID returns the queue ID of the match, falling back to the queue ID of older matches
+func (m *MatchInfo) GetQueueID() int64 {
+	if m.QueueID != 0 {
+		return m.QueueID
+	}
+	return m.LegacyQueueID
+}
+
+// GetDataDragon returns a Data Dragon client serving data of the patch this match was played on
+func (m *MatchInfo) GetDataDragon(client *datadragon.Client) (*datadragon.Client, error) {
+	return client.ForGameVersion(m.GameVersion)
 }
 
 type Participant struct {
@@ -236,9 +279,13 @@ type Participant struct {
 	Level int64 `json:"level"`
 	// Participant placement upon elimination
 	Placement int64 `json:"placement"`
+	// ID of the participant's team in Double Up. Not included for other game types.
+	PartnerGroupID int64 `json:"partner_group_id"`
 	// Number of players the participant eliminated.
 	PlayersEliminated int64  `json:"players_eliminated"`
 	PUUID             string `json:"puuid"`
+	RiotIDGameName    string `json:"riotIdGameName"`
+	RiotIDTagline     string `json:"riotIdTagline"`
 	// The number of seconds before the participant was eliminated
 	TimeEliminated float64 `json:"time_eliminated"`
 	// Damage the participant dealt to other players.
@@ -247,13 +294,35 @@ type Participant struct {
 	Traits []Trait `json:"traits"`
 	// A list of active units for the participant
 	Units []Unit `json:"units"`
+	// The augments chosen by the participant
+	Augments []string `json:"augments"`
+	// Whether the participant placed in the top 4, or their team won in Double Up
+	Win bool `json:"win"`
+}
+
+// GetAugments returns the augments chosen by the participant
+func (p *Participant) GetAugments(client *datadragon.Client) ([]datadragon.TFTAugment, error) {
+	res := make([]datadragon.TFTAugment, 0, len(p.Augments))
+	for _, id := range p.Augments {
+		augment, err := client.GetTFTAugment(id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, augment)
+	}
+	return res, nil
 }
 
+// Companion is the Little Legend of a participant
 type Companion struct {
+	// ID of the companion in the store content
 	ContentID string `json:"content_ID"`
-	ItemID    int64  `json:"item_ID"`
-	SkinID    int64  `json:"skin_ID"`
-	Species   string `json:"species"`
+	// Item ID of the companion, which identifies the species and skin
+	ItemID int64 `json:"item_ID"`
+	// Skin of the species
+	SkinID int64 `json:"skin_ID"`
+	// Species of the companion
+	Species string `json:"species"`
 }
 
 type Trait struct {
@@ -269,11 +338,28 @@ type Trait struct {
 	TierTotal int64 `json:"tier_total"`
 }
 
+// GetTrait returns the name and icon of the trait
+func (t *Trait) GetTrait(client *datadragon.Client) (datadragon.TFTTrait, error) {
+	return client.GetTFTTrait(t.Name)
+}
+
+// GetBreakpoints returns the breakpoints of the trait. Data Dragon does not include them, so they are loaded from
+// Community Dragon. The tier of a breakpoint matches TierCurrent, starting at 1.
+func (t *Trait) GetBreakpoints(client *communitydragon.Client) ([]communitydragon.TFTTraitEffect, error) {
+	trait, err := client.GetTFTTrait(t.Name)
+	if err != nil {
+		return nil, err
+	}
+	return trait.Effects, nil
+}
+
 type Unit struct {
 	// This field was introduced in patch 9.22 with data_version 2.
 	CharacterID string `json:"character_id"`
-	// A list of the unit's items. Please refer to the Teamfight Tactics documentation for item ids.
-	Items []int `json:"itemNames"`
+	// A list of the unit's items, e.g. "TFT_Item_GuinsoosRageblade". Older matches list numeric item IDs instead.
+	Items ItemNames `json:"itemNames"`
+	// A list of the unit's numeric item IDs. Only included in older matches.
+	ItemIDs []int `json:"items"`
 	// If a unit is chosen as part of the Fates set mechanic, the chosen trait
 	// will be indicated by this field. Otherwise this field is excluded from the
 	// response.
@@ -285,6 +371,128 @@ type Unit struct {
 	Tier int `json:"tier"`
 }
 
+// GetChampion returns the name, cost and icon of the unit
+func (u *Unit) GetChampion(client *datadragon.Client) (datadragon.TFTChampion, error) {
+	return client.GetTFTChampion(u.CharacterID)
+}
+
+// GetItemNames returns the items held by the unit, falling back to the numeric item IDs of older matches
+func (u *Unit) GetItemNames() []string {
+	if len(u.Items) > 0 || len(u.ItemIDs) == 0 {
+		return u.Items
+	}
+	res := make([]string, 0, len(u.ItemIDs))
+	for _, id := range u.ItemIDs {
+		res = append(res, strconv.Itoa(id))
+	}
+	return res
+}
+
+// GetItems returns the items held by the unit
+func (u *Unit) GetItems(client *datadragon.Client) ([]datadragon.TFTItem, error) {
+	names := u.GetItemNames()
+	res := make([]datadragon.TFTItem, 0, len(names))
+	for _, id := range names {
+		item, err := client.GetTFTItem(id)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, item)
+	}
+	return res, nil
+}
+
+// ItemNames contains the items of a unit. Numeric item IDs of older matches are decoded as their string
+// representation.
+type ItemNames []string
+
+// UnmarshalJSON decodes a list of item names or numeric item IDs
+func (n *ItemNames) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw == nil {
+		*n = nil
+		return nil
+	}
+	res := make(ItemNames, 0, len(raw))
+	for _, item := range raw {
+		var name string
+		if json.Unmarshal(item, &name) == nil {
+			res = append(res, name)
+			continue
+		}
+		var id json.Number
+		if err := json.Unmarshal(item, &id); err != nil {
+			return err
+		}
+		res = append(res, id.String())
+	}
+	*n = res
+	return nil
+}
+
+// Team is a team of two participants in Double Up
+type Team struct {
+	PartnerGroupID int64
+	// Placement is the placement of the team from 1 to 4
+	Placement    int64
+	Participants []Participant
+}
+
+// IsDoubleUp returns whether the match was played in Double Up
+func (m *MatchInfo) IsDoubleUp() bool {
+	if m.TFTGameType != "" {
+		return m.TFTGameType == GameTypePairs
+	}
+	for i := range m.Participants {
+		if m.Participants[i].PartnerGroupID != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTeams returns the teams of a Double Up match ordered by placement, or nil for other game types
+func (m *MatchInfo) GetTeams() []Team {
+	if !m.IsDoubleUp() {
+		return nil
+	}
+	teams := map[int64]*Team{}
+	var res []*Team
+	for i := range m.Participants {
+		participant := &m.Participants[i]
+		team, ok := teams[participant.PartnerGroupID]
+		if !ok {
+			team = &Team{PartnerGroupID: participant.PartnerGroupID, Placement: participant.TeamPlacement()}
+			teams[participant.PartnerGroupID] = team
+			res = append(res, team)
+		}
+		team.Placement = min(team.Placement, participant.TeamPlacement())
+		team.Participants = append(team.Participants, *participant)
+	}
+	sort.SliceStable(
+		res, func(i, j int) bool {
+			return res[i].Placement < res[j].Placement
+		},
+	)
+	out := make([]Team, 0, len(res))
+	for _, team := range res {
+		out = append(out, *team)
+	}
+	return out
+}
+
+// TeamPlacement returns the placement of the participant's team from 1 to 4 in Double Up, or the placement of
+// the participant for other game types
+func (p *Participant) TeamPlacement() int64 {
+	if p.PartnerGroupID == 0 {
+		return p.Placement
+	}
+	return (p.Placement + 1) / 2
+}
+
 type Metadata struct {
 	DataVersion  string   `json:"data_version"`
 	MatchID      string   `json:"match_id"`
@@ -0,0 +1,237 @@
+package tft
+
+import (
+	"encoding/json"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/communitydragon"
+	"github.com/KnutZuidema/golio/datadragon"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func TestMatchInfo_GetDataDragon(t *testing.T) {
+	t.Parallel()
+	client := datadragon.NewClient(
+		mock.NewJSONMockDoer([]string{"14.4.1", "14.3.1"}, 200), api.RegionKorea, log.StandardLogger(),
+	)
+	model := MatchInfo{GameVersion: "Version 14.3.562.1234 (Feb 01 2024/12:00:00) [PUBLIC] <Releases/14.3>"}
+	got, err := model.GetDataDragon(client)
+	require.Nil(t, err)
+	assert.Equal(t, "14.3.1", got.Version)
+}
+
+func TestParticipant_GetAugments(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		model   Participant
+		want    []datadragon.TFTAugment
+		wantErr error
+	}{
+		{
+			name:  "valid",
+			model: Participant{Augments: []string{"TFT9_Augment_B", "TFT9_Augment_A"}},
+			want:  []datadragon.TFTAugment{{ID: "TFT9_Augment_B"}, {ID: "TFT9_Augment_A"}},
+		},
+		{
+			name:  "no augments",
+			model: Participant{},
+			want:  []datadragon.TFTAugment{},
+		},
+		{
+			name:    "unknown augment",
+			model:   Participant{Augments: []string{"TFT9_Augment_C"}},
+			wantErr: api.ErrNotFound,
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				doer := mock.NewDataDragonMockDoer(
+					map[string]datadragon.TFTAugment{"TFT9_Augment_A": {}, "TFT9_Augment_B": {}},
+				)
+				client := datadragon.NewClient(doer, api.RegionKorea, log.StandardLogger())
+				got, err := test.model.GetAugments(client)
+				assert.Equal(t, test.wantErr, err)
+				assert.Equal(t, test.want, got)
+			},
+		)
+	}
+}
+
+func TestTrait_GetTrait(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewDataDragonMockDoer(map[string]datadragon.TFTTrait{"Set9_Sorcerer": {Name: "Sorcerer"}})
+	client := datadragon.NewClient(doer, api.RegionKorea, log.StandardLogger())
+	got, err := (&Trait{Name: "Set9_Sorcerer"}).GetTrait(client)
+	require.Nil(t, err)
+	assert.Equal(t, datadragon.TFTTrait{ID: "Set9_Sorcerer", Name: "Sorcerer"}, got)
+}
+
+func TestTrait_GetBreakpoints(t *testing.T) {
+	t.Parallel()
+	effects := []communitydragon.TFTTraitEffect{{MinUnits: 2, MaxUnits: 3, Style: 1}, {MinUnits: 4, MaxUnits: 25}}
+	doer := mock.NewJSONMockDoer(
+		communitydragon.TFTData{
+			SetData: []communitydragon.TFTSet{
+				{Mutator: "TFTSet8"},
+				{Mutator: "TFTSet9", Traits: []communitydragon.TFTTrait{{APIName: "Set9_Sorcerer", Effects: effects}}},
+			},
+		}, 200,
+	)
+	client := communitydragon.NewClient(doer, log.StandardLogger())
+	got, err := (&Trait{Name: "Set9_Sorcerer"}).GetBreakpoints(client)
+	require.Nil(t, err)
+	assert.Equal(t, effects, got)
+	_, err = (&Trait{Name: "Set9_Unknown"}).GetBreakpoints(client)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestUnit_GetChampion(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewDataDragonMockDoer(map[string]datadragon.TFTChampion{"TFT9_Ahri": {Name: "Ahri", Tier: 2}})
+	client := datadragon.NewClient(doer, api.RegionKorea, log.StandardLogger())
+	got, err := (&Unit{CharacterID: "TFT9_Ahri"}).GetChampion(client)
+	require.Nil(t, err)
+	assert.Equal(t, datadragon.TFTChampion{ID: "TFT9_Ahri", Name: "Ahri", Tier: 2}, got)
+}
+
+func TestUnit_GetItems(t *testing.T) {
+	t.Parallel()
+	doer := mock.NewDataDragonMockDoer(map[string]datadragon.TFTItem{"1": {Name: "B.F. Sword"}})
+	client := datadragon.NewClient(doer, api.RegionKorea, log.StandardLogger())
+	got, err := (&Unit{Items: ItemNames{"1"}}).GetItems(client)
+	require.Nil(t, err)
+	assert.Equal(t, []datadragon.TFTItem{{ID: "1", Name: "B.F. Sword"}}, got)
+	_, err = (&Unit{ItemIDs: []int{2}}).GetItems(client)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestItemNames_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		data    string
+		want    ItemNames
+		wantErr bool
+	}{
+		{
+			name: "names",
+			data: `["TFT_Item_GuinsoosRageblade","TFT_Item_InfinityEdge"]`,
+			want: ItemNames{"TFT_Item_GuinsoosRageblade", "TFT_Item_InfinityEdge"},
+		},
+		{
+			name: "numeric IDs",
+			data: `[23, 1]`,
+			want: ItemNames{"23", "1"},
+		},
+		{
+			name: "null",
+			data: `null`,
+		},
+		{
+			name:    "invalid",
+			data:    `[true]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				t.Parallel()
+				var got ItemNames
+				err := json.Unmarshal([]byte(tt.data), &got)
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			},
+		)
+	}
+}
+
+func TestUnit_GetItemNames(t *testing.T) {
+	t.Parallel()
+	var unit Unit
+	require.NoError(t, json.Unmarshal([]byte(`{"character_id":"TFT9_Ahri","items":[1,23]}`), &unit))
+	assert.Equal(t, []string{"1", "23"}, unit.GetItemNames())
+	unit.Items = ItemNames{"TFT_Item_InfinityEdge"}
+	assert.Equal(t, []string{"TFT_Item_InfinityEdge"}, unit.GetItemNames())
+}
+
+func TestMatchInfo_GetTeams(t *testing.T) {
+	t.Parallel()
+	info := MatchInfo{
+		TFTGameType: GameTypePairs,
+		Participants: []Participant{
+			{PUUID: "a", PartnerGroupID: 1, Placement: 5},
+			{PUUID: "b", PartnerGroupID: 2, Placement: 1},
+			{PUUID: "c", PartnerGroupID: 1, Placement: 6},
+			{PUUID: "d", PartnerGroupID: 2, Placement: 2},
+		},
+	}
+	assert.True(t, info.IsDoubleUp())
+	assert.Equal(t, int64(3), info.Participants[0].TeamPlacement())
+	teams := info.GetTeams()
+	require.Len(t, teams, 2)
+	assert.Equal(t, int64(2), teams[0].PartnerGroupID)
+	assert.Equal(t, int64(1), teams[0].Placement)
+	assert.Equal(t, []string{"b", "d"}, []string{teams[0].Participants[0].PUUID, teams[0].Participants[1].PUUID})
+	assert.Equal(t, int64(1), teams[1].PartnerGroupID)
+	assert.Equal(t, int64(3), teams[1].Placement)
+
+	standard := MatchInfo{TFTGameType: GameTypeStandard, Participants: []Participant{{Placement: 5}}}
+	assert.False(t, standard.IsDoubleUp())
+	assert.Nil(t, standard.GetTeams())
+	assert.Equal(t, int64(5), standard.Participants[0].TeamPlacement())
+	// matches without a game type are detected by the partner group IDs
+	assert.True(t, (&MatchInfo{Participants: info.Participants}).IsDoubleUp())
+}
+
+func TestMatchInfo_GetQueueID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		data string
+		want int64
+	}{
+		{name: "current", data: `{"queueId":1100,"queue_id":1100}`, want: 1100},
+		{name: "legacy", data: `{"queue_id":1090}`, want: 1090},
+		{name: "missing", data: `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				var info MatchInfo
+				require.NoError(t, json.Unmarshal([]byte(tt.data), &info))
+				assert.Equal(t, tt.want, info.GetQueueID())
+			},
+		)
+	}
+}
+
+func TestParticipant_Companion(t *testing.T) {
+	t.Parallel()
+	var participant Participant
+	data := `{"companion":{"content_ID":"content","item_ID":21001,"skin_ID":1,"species":"PetTFTAvatar"}}`
+	require.NoError(t, json.Unmarshal([]byte(data), &participant))
+	assert.Equal(
+		t, Companion{ContentID: "content", ItemID: 21001, SkinID: 1, Species: "PetTFTAvatar"}, participant.Companion,
+	)
+}
+
+func TestLeagueEntry_IsHyperRoll(t *testing.T) {
+	t.Parallel()
+	var entry LeagueEntry
+	require.NoError(t, json.Unmarshal([]byte(`{"queueType":"RANKED_TFT_TURBO","ratedTier":"PURPLE"}`), &entry))
+	assert.True(t, entry.IsHyperRoll())
+	assert.Equal(t, string(RatedTierPurple), entry.RatedTier)
+	assert.False(t, (&LeagueEntry{QueueType: string(QueueRankedTFT)}).IsHyperRoll())
+}
@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,9 +17,10 @@ type SpectatorClient struct {
 // GetActiveGamesByPUUID returns current game information for the given puuid.
 func (sc *SpectatorClient) GetActiveGamesByPUUID(puuid string) (*CurrentGameInfo, error) {
 	logger := sc.logger().WithField("method", "GetActiveGamesByPUUID")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointSpectatorActiveGamedByPUUID, puuid)
 	var currentGameInfo CurrentGameInfo
-	if err := sc.c.GetInto(url, &currentGameInfo); err != nil {
+	if err := c.GetInto(url, &currentGameInfo); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -28,8 +30,9 @@ func (sc *SpectatorClient) GetActiveGamesByPUUID(puuid string) (*CurrentGameInfo
 // GetFeaturedGames returns a list of featured games
 func (sc *SpectatorClient) GetFeaturedGames() (*FeaturedGames, error) {
 	logger := sc.logger().WithField("method", "GetFeaturedGames")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	var featuredGames FeaturedGames
-	if err := sc.c.GetInto(endpointSpectatorFeaturedGames, &featuredGames); err != nil {
+	if err := c.GetInto(endpointSpectatorFeaturedGames, &featuredGames); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package tft
 import (
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -14,8 +15,9 @@ type StatusClient struct {
 // GetPlatformData returns Teamfight Tactics status for the given platform
 func (sc *StatusClient) GetPlatformData() (*PlatformData, error) {
 	logger := sc.logger().WithField("method", "GetPlatformData")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	var out *PlatformData
-	if err := sc.c.GetInto(endpointStatusPlatformData, &out); err != nil {
+	if err := c.GetInto(endpointStatusPlatformData, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -16,9 +17,10 @@ type SummonerClient struct {
 // GetSummonerByAccountID returns a summoner by account ID
 func (sc *SummonerClient) GetSummonerByAccountID(encryptedAccountID string) (*Summoner, error) {
 	logger := sc.logger().WithField("method", "GetSummonerByAccount")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointSummonerByAccount, encryptedAccountID)
 	var out *Summoner
-	if err := sc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -28,9 +30,10 @@ func (sc *SummonerClient) GetSummonerByAccountID(encryptedAccountID string) (*Su
 // GetSummonerByPUUID returns a summoner by PUUID
 func (sc *SummonerClient) GetSummonerByPUUID(puuid string) (*Summoner, error) {
 	logger := sc.logger().WithField("method", "GetSummonerByPUUID")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointSummonerByPUUID, puuid)
 	var out *Summoner
-	if err := sc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -40,8 +43,9 @@ func (sc *SummonerClient) GetSummonerByPUUID(puuid string) (*Summoner, error) {
 // GetSummonerByMe returns a summoner by access token
 func (sc *SummonerClient) GetSummonerByMe(authorization string) (*Summoner, error) {
 	logger := sc.logger().WithField("method", "GetSummonerByMe")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	var out *Summoner
-	if err := sc.c.GetInto(endpointSummonerByMe, &out,
+	if err := c.GetInto(endpointSummonerByMe, &out,
 		internal.WithHeader("Authorization", authorization)); err != nil {
 		logger.Debug(err)
 		return nil, err
@@ -52,9 +56,10 @@ func (sc *SummonerClient) GetSummonerByMe(authorization string) (*Summoner, erro
 // GetSummonerBySummonerID returns a summoner by summoner ID
 func (sc *SummonerClient) GetSummonerBySummonerID(summonerID string) (*Summoner, error) {
 	logger := sc.logger().WithField("method", "GetSummonerBySummonerID")
+	c := sc.c.ForGame(api.GameTFT, api.FamilyPlatform)
 	url := fmt.Sprintf(endpointSummonerBySummonerID, summonerID)
 	var out *Summoner
-	if err := sc.c.GetInto(url, &out); err != nil {
+	if err := c.GetInto(url, &out); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
@@ -0,0 +1,259 @@
+package val
+
+import (
+	"sort"
+	"time"
+)
+
+// DefaultTradeWindow is the time after a death in which killing the killer counts as a trade
+const DefaultTradeWindow = 5 * time.Second
+
+// CombatStats contains the combat performance of a player across a match
+type CombatStats struct {
+	PUUID        string
+	TeamID       string
+	RoundsPlayed int
+	Score        int
+	Kills        int
+	Deaths       int
+	Assists      int
+	// Damage is the total damage dealt to other players
+	Damage    int
+	Headshots int
+	BodyShots int
+	LegShots  int
+	// FirstKills is the number of rounds in which the player got the first kill
+	FirstKills int
+	// FirstDeaths is the number of rounds in which the player died first
+	FirstDeaths int
+	// MultiKills maps a number of kills in a single round, e.g. 3, to the number of rounds with that many kills.
+	// Only rounds with at least two kills are counted.
+	MultiKills map[int]int
+	// TradeKills is the number of kills on an enemy who killed a teammate within the trade window
+	TradeKills int
+	// TradedDeaths is the number of deaths which were traded by a teammate within the trade window
+	TradedDeaths   int
+	ClutchesPlayed int
+	ClutchesWon    int
+}
+
+// ACS returns the average combat score per round
+func (s *CombatStats) ACS() float64 {
+	return perRound(s.Score, s.RoundsPlayed)
+}
+
+// ADR returns the average damage per round
+func (s *CombatStats) ADR() float64 {
+	return perRound(s.Damage, s.RoundsPlayed)
+}
+
+// KDA returns the ratio of kills and assists to deaths. Zero deaths count as one.
+func (s *CombatStats) KDA() float64 {
+	return float64(s.Kills+s.Assists) / float64(max(s.Deaths, 1))
+}
+
+// KD returns the ratio of kills to deaths. Zero deaths count as one.
+func (s *CombatStats) KD() float64 {
+	return float64(s.Kills) / float64(max(s.Deaths, 1))
+}
+
+// HeadshotPercentage returns the percentage of hits which were headshots
+func (s *CombatStats) HeadshotPercentage() float64 {
+	hits := s.Headshots + s.BodyShots + s.LegShots
+	if hits == 0 {
+		return 0
+	}
+	return float64(s.Headshots) / float64(hits) * 100
+}
+
+// Clutch is a situation in which a player is the last one alive of their team while at least one enemy is alive
+type Clutch struct {
+	RoundNum int
+	PUUID    string
+	// Opponents is the number of enemies alive when the clutch started, e.g. 3 for a 1v3
+	Opponents int
+	Won       bool
+}
+
+// GetCombatStats returns the combat stats of all players of the match by PUUID. Kills on an enemy who killed a
+// teammate at most tradeWindow earlier count as trades. If tradeWindow is zero, DefaultTradeWindow is used.
+func (m *Match) GetCombatStats(tradeWindow time.Duration) map[string]*CombatStats {
+	if tradeWindow == 0 {
+		tradeWindow = DefaultTradeWindow
+	}
+	res := make(map[string]*CombatStats, len(m.Players))
+	for _, player := range m.Players {
+		res[player.PuuID] = &CombatStats{
+			PUUID:        player.PuuID,
+			TeamID:       player.TeamID,
+			RoundsPlayed: player.Stats.RoundsPlayed,
+			Score:        player.Stats.Score,
+			Kills:        player.Stats.Kills,
+			Deaths:       player.Stats.Deaths,
+			Assists:      player.Stats.Assists,
+			MultiKills:   map[int]int{},
+		}
+	}
+	teams := m.playerTeams()
+	for i := range m.RoundResults {
+		round := &m.RoundResults[i]
+		addRoundDamage(res, round)
+		kills := round.GetKills()
+		addOpeningDuel(res, kills)
+		addMultiKills(res, kills)
+		addTrades(res, kills, teams, tradeWindow)
+	}
+	for _, clutch := range m.GetClutches() {
+		if stats, ok := res[clutch.PUUID]; ok {
+			stats.ClutchesPlayed++
+			if clutch.Won {
+				stats.ClutchesWon++
+			}
+		}
+	}
+	return res
+}
+
+// GetClutches returns all clutch situations of the match in order of the rounds
+func (m *Match) GetClutches() []Clutch {
+	teams := m.playerTeams()
+	var res []Clutch
+	for i := range m.RoundResults {
+		res = append(res, m.RoundResults[i].clutches(teams)...)
+	}
+	return res
+}
+
+// GetKills returns all kills of the round ordered by time
+func (r *RoundResult) GetKills() []Kill {
+	var res []Kill
+	for _, stats := range r.PlayerStats {
+		res = append(res, stats.Kills...)
+	}
+	sort.SliceStable(
+		res, func(i, j int) bool {
+			return res[i].TimeSinceRoundStartMillis < res[j].TimeSinceRoundStartMillis
+		},
+	)
+	return res
+}
+
+// clutches returns the clutch situation of the round, if any. All players of the given teams are alive at the
+// start of the round. Only the player of the team which is first reduced to one player by a kill is in a clutch,
+// so a 1v1 following a 1vX is not a clutch of the other player.
+func (r *RoundResult) clutches(teams map[string]string) []Clutch {
+	alive := map[string]map[string]bool{}
+	for puuid, team := range teams {
+		if alive[team] == nil {
+			alive[team] = map[string]bool{}
+		}
+		alive[team][puuid] = true
+	}
+	for _, kill := range r.GetKills() {
+		team := teams[kill.Victim]
+		delete(alive[team], kill.Victim)
+		opponents := aliveOpponents(alive, team)
+		if len(alive[team]) != 1 || opponents == 0 {
+			continue
+		}
+		for puuid := range alive[team] {
+			return []Clutch{{RoundNum: r.RoundNum, PUUID: puuid, Opponents: opponents, Won: r.WinningTeam == team}}
+		}
+	}
+	return nil
+}
+
+// playerTeams maps the PUUID of each player to their team
+func (m *Match) playerTeams() map[string]string {
+	res := make(map[string]string, len(m.Players))
+	for _, player := range m.Players {
+		res[player.PuuID] = player.TeamID
+	}
+	return res
+}
+
+func aliveOpponents(alive map[string]map[string]bool, team string) int {
+	var res int
+	for other, players := range alive {
+		if other != team {
+			res += len(players)
+		}
+	}
+	return res
+}
+
+func addRoundDamage(res map[string]*CombatStats, round *RoundResult) {
+	for _, roundStats := range round.PlayerStats {
+		stats, ok := res[roundStats.PUUID]
+		if !ok {
+			continue
+		}
+		for _, damage := range roundStats.Damages {
+			stats.Damage += damage.Damage
+			stats.Headshots += damage.Headshots
+			stats.BodyShots += damage.BodyShots
+			stats.LegShots += damage.LegShots
+		}
+	}
+}
+
+// addOpeningDuel counts the first kill and death of a round given its kills ordered by time
+func addOpeningDuel(res map[string]*CombatStats, kills []Kill) {
+	if len(kills) == 0 {
+		return
+	}
+	if stats, ok := res[kills[0].Killer]; ok {
+		stats.FirstKills++
+	}
+	if stats, ok := res[kills[0].Victim]; ok {
+		stats.FirstDeaths++
+	}
+}
+
+func addMultiKills(res map[string]*CombatStats, kills []Kill) {
+	counts := map[string]int{}
+	for _, kill := range kills {
+		if kill.Killer != kill.Victim {
+			counts[kill.Killer]++
+		}
+	}
+	for puuid, count := range counts {
+		if stats, ok := res[puuid]; ok && count > 1 {
+			stats.MultiKills[count]++
+		}
+	}
+}
+
+// addTrades counts trade kills and traded deaths of a round given its kills ordered by time
+func addTrades(res map[string]*CombatStats, kills []Kill, teams map[string]string, window time.Duration) {
+	traded := map[int]bool{}
+	for j, trade := range kills {
+		isTrade := false
+		for i := j - 1; i >= 0; i-- {
+			death := kills[i]
+			elapsed := time.Duration(trade.TimeSinceRoundStartMillis-death.TimeSinceRoundStartMillis) * time.Millisecond
+			if elapsed > window {
+				break
+			}
+			if death.Killer != trade.Victim || teams[death.Victim] != teams[trade.Killer] ||
+				death.Victim == trade.Killer || traded[i] {
+				continue
+			}
+			traded[i] = true
+			isTrade = true
+			if stats, ok := res[death.Victim]; ok {
+				stats.TradedDeaths++
+			}
+		}
+		if stats, ok := res[trade.Killer]; ok && isTrade {
+			stats.TradeKills++
+		}
+	}
+}
+
+func perRound(value, rounds int) float64 {
+	if rounds == 0 {
+		return 0
+	}
+	return float64(value) / float64(rounds)
+}
@@ -0,0 +1,155 @@
+package val
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCombatMatch() *Match {
+	player := func(puuid, team string, score, kills, deaths int) MatchPlayer {
+		return MatchPlayer{
+			PuuID:  puuid,
+			TeamID: team,
+			Stats:  PlayerStats{Score: score, RoundsPlayed: 2, Kills: kills, Deaths: deaths, Assists: 1},
+		}
+	}
+	kill := func(millis int, killer, victim string) Kill {
+		return Kill{TimeSinceRoundStartMillis: millis, Killer: killer, Victim: victim}
+	}
+	return &Match{
+		Players: []MatchPlayer{
+			player("a1", "Blue", 500, 2, 1),
+			player("a2", "Blue", 300, 1, 1),
+			player("b1", "Red", 200, 1, 2),
+			player("b2", "Red", 250, 1, 1),
+		},
+		RoundResults: []RoundResult{
+			{
+				RoundNum:    0,
+				WinningTeam: "Red",
+				PlayerStats: []PlayerRoundStats{
+					{
+						PUUID:   "a2",
+						Kills:   []Kill{kill(3000, "a2", "b1")},
+						Damages: []Damage{{Receiver: "b1", Damage: 150, Headshots: 1, BodyShots: 2}},
+					},
+					{
+						PUUID:   "b1",
+						Kills:   []Kill{kill(1000, "b1", "a1")},
+						Damages: []Damage{{Receiver: "a1", Damage: 160, BodyShots: 1, LegShots: 1}},
+					},
+					{PUUID: "b2", Kills: []Kill{kill(20000, "b2", "a2")}},
+				},
+			},
+			{
+				RoundNum:    1,
+				WinningTeam: "Blue",
+				PlayerStats: []PlayerRoundStats{
+					{PUUID: "a1", Kills: []Kill{kill(800, "a1", "b2"), kill(500, "a1", "b1")}},
+				},
+			},
+		},
+	}
+}
+
+func TestMatch_GetClutches(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t, []Clutch{
+			{RoundNum: 0, PUUID: "a2", Opponents: 2, Won: false},
+			{RoundNum: 1, PUUID: "b2", Opponents: 2, Won: false},
+		}, testCombatMatch().GetClutches(),
+	)
+}
+
+func TestRoundResult_clutches(t *testing.T) {
+	t.Parallel()
+	kill := func(millis int, killer, victim string) PlayerRoundStats {
+		return PlayerRoundStats{
+			PUUID: killer,
+			Kills: []Kill{{TimeSinceRoundStartMillis: millis, Killer: killer, Victim: victim}},
+		}
+	}
+	tests := []struct {
+		name  string
+		teams map[string]string
+		stats []PlayerRoundStats
+		want  []Clutch
+	}{
+		{
+			name:  "1v1 after 1v3",
+			teams: map[string]string{"a1": "Blue", "a2": "Blue", "b1": "Red", "b2": "Red", "b3": "Red"},
+			stats: []PlayerRoundStats{kill(1000, "b1", "a1"), kill(2000, "a2", "b1"), kill(3000, "a2", "b2")},
+			want:  []Clutch{{RoundNum: 3, PUUID: "a2", Opponents: 3, Won: true}},
+		},
+		{
+			name:  "1v1 after 2v2",
+			teams: map[string]string{"a1": "Blue", "a2": "Blue", "b1": "Red", "b2": "Red"},
+			stats: []PlayerRoundStats{kill(1000, "b1", "a1"), kill(2000, "a2", "b1")},
+			want:  []Clutch{{RoundNum: 3, PUUID: "a2", Opponents: 2, Won: true}},
+		},
+		{
+			name:  "1v1 from the start",
+			teams: map[string]string{"a1": "Blue", "b1": "Red"},
+			stats: []PlayerRoundStats{kill(1000, "a1", "b1")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				round := &RoundResult{RoundNum: 3, WinningTeam: "Blue", PlayerStats: tt.stats}
+				assert.Equal(t, tt.want, round.clutches(tt.teams))
+			},
+		)
+	}
+}
+
+func TestMatch_GetCombatStats(t *testing.T) {
+	t.Parallel()
+	stats := testCombatMatch().GetCombatStats(0)
+	require.Len(t, stats, 4)
+
+	a1 := stats["a1"]
+	assert.Equal(t, 1, a1.FirstKills)
+	assert.Equal(t, 1, a1.FirstDeaths)
+	assert.Equal(t, map[int]int{2: 1}, a1.MultiKills)
+	assert.Equal(t, 1, a1.TradedDeaths)
+	assert.Equal(t, 0, a1.TradeKills)
+	assert.Equal(t, 250.0, a1.ACS())
+	assert.Equal(t, 3.0, a1.KDA())
+	assert.Equal(t, 2.0, a1.KD())
+
+	a2 := stats["a2"]
+	assert.Equal(t, 1, a2.TradeKills)
+	assert.Equal(t, 1, a2.ClutchesPlayed)
+	assert.Equal(t, 0, a2.ClutchesWon)
+	assert.Equal(t, 150, a2.Damage)
+	assert.Equal(t, 75.0, a2.ADR())
+	assert.InDelta(t, 100.0/3, a2.HeadshotPercentage(), 1e-9)
+	assert.Empty(t, a2.MultiKills)
+
+	b1 := stats["b1"]
+	assert.Equal(t, 1, b1.FirstKills)
+	assert.Equal(t, 1, b1.FirstDeaths)
+	assert.Equal(t, 0.0, b1.HeadshotPercentage())
+
+	b2 := stats["b2"]
+	assert.Equal(t, 1, b2.ClutchesPlayed)
+	assert.Equal(t, 0, b2.ClutchesWon)
+	assert.Equal(t, 0.0, b2.HeadshotPercentage())
+
+	stats = testCombatMatch().GetCombatStats(time.Second)
+	assert.Equal(t, 0, stats["a2"].TradeKills)
+	assert.Equal(t, 0, stats["a1"].TradedDeaths)
+}
+
+func TestCombatStats_NoRounds(t *testing.T) {
+	t.Parallel()
+	stats := &CombatStats{Kills: 3}
+	assert.Equal(t, 0.0, stats.ACS())
+	assert.Equal(t, 0.0, stats.ADR())
+	assert.Equal(t, 3.0, stats.KD())
+}
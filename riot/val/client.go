@@ -1,6 +1,9 @@
 package val
 
-import "github.com/KnutZuidema/golio/internal"
+import (
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/riot/account"
+)
 
 // Client pools all methods for endpoints of the Valorant API.
 type Client struct {
@@ -19,3 +22,12 @@ func NewClient(base *internal.Client) *Client {
 		Match:   &MatchClient{c: base},
 	}
 }
+
+// WithActiveShards returns a copy of the client whose player-scoped methods send requests to the active shard of
+// the player, which is looked up using the given cache. This allows querying players of all shards with a single
+// client.
+func (c *Client) WithActiveShards(shards *account.ShardCache) *Client {
+	res := *c
+	res.Match = &MatchClient{c: c.Match.c, shards: shards}
+	return &res
+}
@@ -1,13 +1,20 @@
 package val
 
 import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 	"github.com/KnutZuidema/golio/internal/mock"
+	"github.com/KnutZuidema/golio/riot/account"
 )
 
 func TestNewClient(t *testing.T) {
@@ -16,3 +23,47 @@ func TestNewClient(t *testing.T) {
 		t.Error("returned nil")
 	}
 }
+
+func TestClient_WithActiveShards(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	hosts := map[string]string{}
+	matches := &matchDoer{}
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			mu.Lock()
+			hosts[r.URL.Path] = r.URL.Host
+			mu.Unlock()
+			if strings.Contains(r.URL.Path, "/active-shards/") {
+				return mock.NewJSONMockDoer(account.ActiveShard{ActiveShard: "na"}, http.StatusOK).Do(r)
+			}
+			return matches.Do(r)
+		},
+	}
+	base := internal.NewClient(api.RegionEuropeWest, "key", doer, log.StandardLogger())
+	shards := account.NewShardCache(account.NewClient(base), account.DefaultShardTTL)
+	client := NewClient(base)
+	routed := client.WithActiveShards(shards)
+
+	_, err := routed.Match.GetMatchListByPUUID("puuid")
+	require.NoError(t, err)
+	assert.Equal(t, "na.api.riotgames.com", hosts["/val/match/v1/matchlists/by-puuid/puuid"])
+	assert.Equal(t, "europe.api.riotgames.com", hosts["/riot/account/v1/active-shards/by-game/val/by-puuid/puuid"])
+
+	options := &MatchListOptions{Queues: []string{QueueUnrated}}
+	for value := range routed.Match.MatchHistoryStream(context.Background(), "puuid", options, 1) {
+		require.NoError(t, value.Error)
+	}
+	assert.Equal(t, "na.api.riotgames.com", hosts["/val/match/v1/matches/m2"])
+
+	player, err := routed.Match.ForPlayer("puuid")
+	require.NoError(t, err)
+	_, err = player.GetMatchByID("m1")
+	require.NoError(t, err)
+	assert.Equal(t, "na.api.riotgames.com", hosts["/val/match/v1/matches/m1"])
+
+	// the original client keeps using its own region
+	_, err = client.Match.GetMatchListByPUUID("puuid")
+	require.NoError(t, err)
+	assert.Equal(t, "eu.api.riotgames.com", hosts["/val/match/v1/matchlists/by-puuid/puuid"])
+}
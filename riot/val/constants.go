@@ -1,6 +1,10 @@
 package val
 
-import "github.com/KnutZuidema/golio/api"
+import (
+	"fmt"
+
+	"github.com/KnutZuidema/golio/api"
+)
 
 const (
 	endpointBase                  = "/val"
@@ -18,13 +22,13 @@ const (
 
 // All existing regions
 const (
-	RegionAsiaPacific  api.Region = "ap"
-	RegionBrazil       api.Region = "br"
-	RegionESPORTS      api.Region = "esports"
-	RegionEurope       api.Region = "eu"
-	RegionKorea        api.Region = "kr"
-	RegionLatinAmerica api.Region = "latam"
-	RegionNorthAmerica api.Region = "na"
+	RegionAsiaPacific  = api.ValShardAsiaPacific
+	RegionBrazil       = api.ValShardBrazil
+	RegionESPORTS      = api.ValShardESPORTS
+	RegionEurope       = api.ValShardEurope
+	RegionKorea        = api.ValShardKorea
+	RegionLatinAmerica = api.ValShardLatinAmerica
+	RegionNorthAmerica = api.ValShardNorthAmerica
 )
 
 var (
@@ -39,18 +43,21 @@ var (
 		RegionNorthAmerica,
 	}
 
-	// RegionToRoute maps each region to its route
-	RegionToRoute = map[api.Region]api.Route{
-		RegionAsiaPacific:  api.RouteAsia,
-		RegionBrazil:       api.RouteAmericas,
-		RegionESPORTS:      api.RouteAmericas, //todo: fix the correct route for esports region
-		RegionEurope:       api.RouteEurope,
-		RegionKorea:        api.RouteAsia,
-		RegionLatinAmerica: api.RouteAmericas,
-		RegionNorthAmerica: api.RouteAmericas,
-	}
+	// RegionToRoute maps each region to the route of its accounts
+	//
+	// Deprecated: Use api.Host with api.GameAccount instead.
+	RegionToRoute = regionToRoute()
 )
 
+func regionToRoute() map[api.Region]api.Route {
+	res := make(map[api.Region]api.Route, len(Regions))
+	for _, region := range Regions {
+		route, _ := api.Host(api.GameAccount, api.FamilyRegional, region)
+		res[region] = api.Route(route)
+	}
+	return res
+}
+
 // Locale string value for language
 type Locale string
 
@@ -76,3 +83,132 @@ const (
 	LocaleChina              Locale = "zh-CN"
 	LocaleTaiwan             Locale = "zh-TW"
 )
+
+// Result codes of a round
+const (
+	RoundResultCodeElimination = "Elimination"
+	RoundResultCodeDefuse      = "Defuse"
+	RoundResultCodeDetonate    = "Detonate"
+	RoundResultCodeSurrendered = "Surrendered"
+)
+
+// Queue IDs used in match lists and for recent matches
+const (
+	QueueCompetitive    = "competitive"
+	QueueUnrated        = "unrated"
+	QueueSpikeRush      = "spikerush"
+	QueueDeathmatch     = "deathmatch"
+	QueueTeamDeathmatch = "hurm"
+	QueueSwiftplay      = "swiftplay"
+	QueuePremier        = "premier"
+	QueueEscalation     = "ggteam"
+	QueueReplication    = "onefa"
+	QueueSnowballFight  = "snowball"
+	QueueTournamentMode = "tournamentmode"
+)
+
+// Types of an Act in ContentInfo
+const (
+	ActTypeAct     = "act"
+	ActTypeEpisode = "episode"
+)
+
+// CompetitiveTier is the competitive rank of a player
+type CompetitiveTier int
+
+// All possible values of CompetitiveTier. Tiers 1 and 2 are unused.
+const (
+	CompetitiveTierUnranked CompetitiveTier = iota
+	CompetitiveTierUnused1
+	CompetitiveTierUnused2
+	CompetitiveTierIron1
+	CompetitiveTierIron2
+	CompetitiveTierIron3
+	CompetitiveTierBronze1
+	CompetitiveTierBronze2
+	CompetitiveTierBronze3
+	CompetitiveTierSilver1
+	CompetitiveTierSilver2
+	CompetitiveTierSilver3
+	CompetitiveTierGold1
+	CompetitiveTierGold2
+	CompetitiveTierGold3
+	CompetitiveTierPlatinum1
+	CompetitiveTierPlatinum2
+	CompetitiveTierPlatinum3
+	CompetitiveTierDiamond1
+	CompetitiveTierDiamond2
+	CompetitiveTierDiamond3
+	CompetitiveTierAscendant1
+	CompetitiveTierAscendant2
+	CompetitiveTierAscendant3
+	CompetitiveTierImmortal1
+	CompetitiveTierImmortal2
+	CompetitiveTierImmortal3
+	CompetitiveTierRadiant
+)
+
+var (
+	// CompetitiveTiers is a list of all competitive tiers a player can have, ordered from lowest to highest
+	CompetitiveTiers = []CompetitiveTier{
+		CompetitiveTierUnranked,
+		CompetitiveTierIron1,
+		CompetitiveTierIron2,
+		CompetitiveTierIron3,
+		CompetitiveTierBronze1,
+		CompetitiveTierBronze2,
+		CompetitiveTierBronze3,
+		CompetitiveTierSilver1,
+		CompetitiveTierSilver2,
+		CompetitiveTierSilver3,
+		CompetitiveTierGold1,
+		CompetitiveTierGold2,
+		CompetitiveTierGold3,
+		CompetitiveTierPlatinum1,
+		CompetitiveTierPlatinum2,
+		CompetitiveTierPlatinum3,
+		CompetitiveTierDiamond1,
+		CompetitiveTierDiamond2,
+		CompetitiveTierDiamond3,
+		CompetitiveTierAscendant1,
+		CompetitiveTierAscendant2,
+		CompetitiveTierAscendant3,
+		CompetitiveTierImmortal1,
+		CompetitiveTierImmortal2,
+		CompetitiveTierImmortal3,
+		CompetitiveTierRadiant,
+	}
+
+	// competitiveRanks contains the names of the ranks which are divided into three tiers, starting at Iron 1
+	competitiveRanks = []string{"Iron", "Bronze", "Silver", "Gold", "Platinum", "Diamond", "Ascendant", "Immortal"}
+)
+
+// Rank returns the name of the rank without division, e.g. "Gold" for Gold 2
+func (t CompetitiveTier) Rank() string {
+	switch {
+	case t == CompetitiveTierUnranked:
+		return "Unranked"
+	case t == CompetitiveTierRadiant:
+		return "Radiant"
+	case t >= CompetitiveTierIron1 && t < CompetitiveTierRadiant:
+		return competitiveRanks[(t-CompetitiveTierIron1)/3]
+	default:
+		return "Unknown"
+	}
+}
+
+// Division returns the division of the tier within its rank from 1 to 3, or 0 if the rank has no divisions
+func (t CompetitiveTier) Division() int {
+	if t < CompetitiveTierIron1 || t >= CompetitiveTierRadiant {
+		return 0
+	}
+	return int(t-CompetitiveTierIron1)%3 + 1
+}
+
+// String returns the display name of the tier, e.g. "Gold 2"
+func (t CompetitiveTier) String() string {
+	if division := t.Division(); division > 0 {
+		return fmt.Sprintf("%s %d", t.Rank(), division)
+	}
+	return t.Rank()
+}
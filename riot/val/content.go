@@ -2,15 +2,19 @@ package val
 
 import (
 	"fmt"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
 // ContentClient provides methods for the content endpoints of the VALORANT API.
 type ContentClient struct {
-	c *internal.Client
+	c       *internal.Client
+	mu      sync.Mutex
+	indices map[Locale]*ContentIndex
 }
 
 // GetContent returns information about the in-game contents e.g. skins, maps, etc.
@@ -21,7 +25,8 @@ func (cc *ContentClient) GetContent(locale Locale) (*ContentInfo, error) {
 		url = fmt.Sprintf(endPointGetContent, locale)
 	}
 	var contents *ContentInfo
-	if err := cc.c.GetInto(url, &contents); err != nil {
+	c := cc.c.ForGame(api.GameVAL, api.FamilyPlatform)
+	if err := c.GetInto(url, &contents); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -0,0 +1,183 @@
+package val
+
+import (
+	"strings"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// ContentIndex resolves the IDs and asset paths used in matches to the content items of a ContentInfo
+type ContentIndex struct {
+	Version string
+	Locale  Locale
+	info    *ContentInfo
+	// items maps lower case IDs and asset paths of all content items to the items
+	items map[string]*ContentItem
+	acts  map[string]*Act
+}
+
+// NewContentIndex returns a new index of the given content for the given locale
+func NewContentIndex(info *ContentInfo, locale Locale) *ContentIndex {
+	idx := &ContentIndex{
+		Version: info.Version,
+		Locale:  locale,
+		info:    info,
+		items:   map[string]*ContentItem{},
+		acts:    map[string]*Act{},
+	}
+	for _, items := range [][]*ContentItem{
+		info.Characters, info.Maps, info.Equips, info.GameModes, info.PlayerCards, info.PlayerTitles,
+	} {
+		for _, item := range items {
+			idx.items[strings.ToLower(item.ID)] = item
+			if item.AssetPath != "" {
+				idx.items[strings.ToLower(item.AssetPath)] = item
+			}
+		}
+	}
+	for _, act := range info.Acts {
+		idx.acts[strings.ToLower(act.ID)] = act
+	}
+	return idx
+}
+
+// Content returns the content the index was built from
+func (idx *ContentIndex) Content() *ContentInfo {
+	return idx.info
+}
+
+// Item returns the content item with the given ID or asset path. Asset paths of game modes used in matches, e.g.
+// "/Game/GameModes/Bomb/BombGameMode.BombGameMode_C", are matched without the class suffix.
+func (idx *ContentIndex) Item(idOrPath string) (*ContentItem, error) {
+	key := strings.ToLower(idOrPath)
+	if item, ok := idx.items[key]; ok {
+		return item, nil
+	}
+	if i := strings.LastIndex(key, "."); i > 0 {
+		if item, ok := idx.items[key[:i]]; ok {
+			return item, nil
+		}
+	}
+	return nil, api.ErrNotFound
+}
+
+// Act returns the act or episode with the given ID
+func (idx *ContentIndex) Act(id string) (*Act, error) {
+	act, ok := idx.acts[strings.ToLower(id)]
+	if !ok {
+		return nil, api.ErrNotFound
+	}
+	return act, nil
+}
+
+// ActiveAct returns the currently active act
+func (idx *ContentIndex) ActiveAct() (*Act, error) {
+	return idx.active(ActTypeAct)
+}
+
+// ActiveEpisode returns the currently active episode
+func (idx *ContentIndex) ActiveEpisode() (*Act, error) {
+	return idx.active(ActTypeEpisode)
+}
+
+func (idx *ContentIndex) active(actType string) (*Act, error) {
+	for _, act := range idx.info.Acts {
+		// older content versions do not include the type, in which case only acts are returned as active
+		if act.IsActive && (act.Type == actType || (act.Type == "" && actType == ActTypeAct)) {
+			return act, nil
+		}
+	}
+	return nil, api.ErrNotFound
+}
+
+// GetContentIndex returns an index of the content in the given locale. The index is cached per locale, use
+// RefreshContentIndex to load a new content version.
+func (cc *ContentClient) GetContentIndex(locale Locale) (*ContentIndex, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if idx, ok := cc.indices[locale]; ok {
+		return idx, nil
+	}
+	return cc.loadContentIndex(locale)
+}
+
+// RefreshContentIndex loads the content in the given locale and replaces the cached index if the content version
+// changed. It returns whether the index was replaced.
+func (cc *ContentClient) RefreshContentIndex(locale Locale) (*ContentIndex, bool, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	previous, cached := cc.indices[locale]
+	content, err := cc.GetContent(locale)
+	if err != nil {
+		return nil, false, err
+	}
+	if cached && previous.Version == content.Version {
+		return previous, false, nil
+	}
+	idx := NewContentIndex(content, locale)
+	cc.storeContentIndex(idx)
+	return idx, true, nil
+}
+
+func (cc *ContentClient) loadContentIndex(locale Locale) (*ContentIndex, error) {
+	content, err := cc.GetContent(locale)
+	if err != nil {
+		return nil, err
+	}
+	idx := NewContentIndex(content, locale)
+	cc.storeContentIndex(idx)
+	return idx, nil
+}
+
+func (cc *ContentClient) storeContentIndex(idx *ContentIndex) {
+	if cc.indices == nil {
+		cc.indices = map[Locale]*ContentIndex{}
+	}
+	cc.indices[idx.Locale] = idx
+}
+
+// GetCharacter returns the agent played by the player
+func (p *MatchPlayer) GetCharacter(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(p.CharacterID)
+}
+
+// GetPlayerCard returns the player card equipped by the player
+func (p *MatchPlayer) GetPlayerCard(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(p.PlayerCard)
+}
+
+// GetPlayerTitle returns the title equipped by the player
+func (p *MatchPlayer) GetPlayerTitle(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(p.PlayerTitle)
+}
+
+// GetMap returns the map the match was played on
+func (m *MatchInfo) GetMap(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(m.MapID)
+}
+
+// GetGameMode returns the game mode of the match
+func (m *MatchInfo) GetGameMode(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(m.GameMode)
+}
+
+// GetAct returns the act the match was played in
+func (m *MatchInfo) GetAct(idx *ContentIndex) (*Act, error) {
+	return idx.Act(m.SeasonID)
+}
+
+// GetWeapon returns the weapon bought by the player
+func (e *Economy) GetWeapon(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(e.Weapon)
+}
+
+// GetArmor returns the armor bought by the player
+func (e *Economy) GetArmor(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(e.Armor)
+}
+
+// GetDamageItem returns the weapon which dealt the finishing damage. Abilities, e.g. "Ultimate", are not part of
+// the content and return api.ErrNotFound.
+func (d *FinishingDamage) GetDamageItem(idx *ContentIndex) (*ContentItem, error) {
+	return idx.Item(d.DamageItem)
+}
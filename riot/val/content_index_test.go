@@ -0,0 +1,188 @@
+package val
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+func testContentInfo(version string) *ContentInfo {
+	return &ContentInfo{
+		Version:    version,
+		Characters: []*ContentItem{{Name: "Jett", ID: "ADD6443A-41BD-E414-F6AD-E58D267F4E95"}},
+		Maps: []*ContentItem{
+			{Name: "Ascent", ID: "7EAECC1B-4337-BBF6-6AB9-04B8F06B3319", AssetPath: "/Game/Maps/Ascent/Ascent"},
+		},
+		Equips: []*ContentItem{
+			{Name: "Vandal", ID: "9C82E19D-4575-0200-1A81-3EACF00CF872"},
+			{Name: "Heavy Shields", ID: "822BCAB2-40A2-324E-C137-E09195AD7692"},
+		},
+		GameModes: []*ContentItem{
+			{
+				Name: "Standard", ID: "96BD3920-4F36-D026-2B28-C683EB0BCAC5",
+				AssetPath: "/Game/GameModes/Bomb/BombGameMode",
+			},
+		},
+		PlayerCards:  []*ContentItem{{Name: "Card", ID: "card"}},
+		PlayerTitles: []*ContentItem{{Name: "Title", ID: "title"}},
+		Acts: []*Act{
+			{Name: "EPISODE 8", ID: "episode", IsActive: true, Type: ActTypeEpisode},
+			{Name: "ACT I", ID: "old", ParentID: "episode", Type: ActTypeAct},
+			{Name: "ACT II", ID: "act", ParentID: "episode", IsActive: true, Type: ActTypeAct},
+		},
+	}
+}
+
+func TestContentIndex_Resolvers(t *testing.T) {
+	t.Parallel()
+	idx := NewContentIndex(testContentInfo("release-08.00"), LocaleUnitedStates)
+	player := &MatchPlayer{
+		CharacterID: "add6443a-41bd-e414-f6ad-e58d267f4e95", PlayerCard: "card", PlayerTitle: "title",
+	}
+	character, err := player.GetCharacter(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Jett", character.Name)
+	card, err := player.GetPlayerCard(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Card", card.Name)
+	title, err := player.GetPlayerTitle(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Title", title.Name)
+
+	info := &MatchInfo{
+		MapID: "/Game/Maps/Ascent/Ascent", GameMode: "/Game/GameModes/Bomb/BombGameMode.BombGameMode_C",
+		SeasonID: "ACT",
+	}
+	m, err := info.GetMap(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Ascent", m.Name)
+	mode, err := info.GetGameMode(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Standard", mode.Name)
+	act, err := info.GetAct(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "ACT II", act.Name)
+
+	economy := &Economy{Weapon: "9c82e19d-4575-0200-1a81-3eacf00cf872", Armor: "822bcab2-40a2-324e-c137-e09195ad7692"}
+	weapon, err := economy.GetWeapon(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Vandal", weapon.Name)
+	armor, err := economy.GetArmor(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Heavy Shields", armor.Name)
+	damage := &FinishingDamage{DamageItem: "9C82E19D-4575-0200-1A81-3EACF00CF872"}
+	weapon, err = damage.GetDamageItem(idx)
+	require.Nil(t, err)
+	assert.Equal(t, "Vandal", weapon.Name)
+	_, err = (&FinishingDamage{DamageItem: "Ultimate"}).GetDamageItem(idx)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = idx.Act("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	assert.Equal(t, "release-08.00", idx.Content().Version)
+}
+
+func TestContentIndex_Active(t *testing.T) {
+	t.Parallel()
+	idx := NewContentIndex(testContentInfo(""), LocaleUnitedStates)
+	act, err := idx.ActiveAct()
+	require.Nil(t, err)
+	assert.Equal(t, "act", act.ID)
+	episode, err := idx.ActiveEpisode()
+	require.Nil(t, err)
+	assert.Equal(t, "episode", episode.ID)
+
+	idx = NewContentIndex(&ContentInfo{Acts: []*Act{{ID: "untyped", IsActive: true}}}, LocaleUnitedStates)
+	act, err = idx.ActiveAct()
+	require.Nil(t, err)
+	assert.Equal(t, "untyped", act.ID)
+	_, err = idx.ActiveEpisode()
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestContentClient_GetContentIndex(t *testing.T) {
+	t.Parallel()
+	var requests int
+	version := "release-08.00"
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requests++
+			return mock.NewJSONMockDoer(testContentInfo(version), http.StatusOK).Do(r)
+		},
+	}
+	client := internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())
+	cc := &ContentClient{c: client}
+	idx, err := cc.GetContentIndex(LocaleUnitedStates)
+	require.Nil(t, err)
+	assert.Equal(t, "release-08.00", idx.Version)
+	cached, err := cc.GetContentIndex(LocaleUnitedStates)
+	require.Nil(t, err)
+	assert.Same(t, idx, cached)
+	assert.Equal(t, 1, requests)
+
+	refreshed, changed, err := cc.RefreshContentIndex(LocaleUnitedStates)
+	require.Nil(t, err)
+	assert.False(t, changed)
+	assert.Same(t, idx, refreshed)
+
+	version = "release-08.01"
+	refreshed, changed, err = cc.RefreshContentIndex(LocaleUnitedStates)
+	require.Nil(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "release-08.01", refreshed.Version)
+	cached, err = cc.GetContentIndex(LocaleUnitedStates)
+	require.Nil(t, err)
+	assert.Same(t, refreshed, cached)
+	assert.Equal(t, 3, requests)
+
+	_, err = cc.GetContentIndex(LocaleGermany)
+	require.Nil(t, err)
+	assert.Equal(t, 4, requests)
+}
+
+func TestContentClient_GetContentIndex_Error(t *testing.T) {
+	t.Parallel()
+	client := internal.NewClient(
+		api.RegionEuropeWest, "API_KEY", mock.NewStatusMockDoer(http.StatusNotFound), logrus.StandardLogger(),
+	)
+	cc := &ContentClient{c: client}
+	_, err := cc.GetContentIndex(LocaleUnitedStates)
+	assert.Equal(t, api.ErrNotFound, err)
+	_, _, err = cc.RefreshContentIndex(LocaleUnitedStates)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestCompetitiveTier(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		tier     CompetitiveTier
+		rank     string
+		division int
+		name     string
+	}{
+		{tier: CompetitiveTierUnranked, rank: "Unranked", name: "Unranked"},
+		{tier: CompetitiveTierUnused1, rank: "Unknown", name: "Unknown"},
+		{tier: CompetitiveTierIron1, rank: "Iron", division: 1, name: "Iron 1"},
+		{tier: CompetitiveTierGold2, rank: "Gold", division: 2, name: "Gold 2"},
+		{tier: CompetitiveTierImmortal3, rank: "Immortal", division: 3, name: "Immortal 3"},
+		{tier: CompetitiveTierRadiant, rank: "Radiant", name: "Radiant"},
+		{tier: 28, rank: "Unknown", name: "Unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.rank, tt.tier.Rank())
+				assert.Equal(t, tt.division, tt.tier.Division())
+				assert.Equal(t, tt.name, tt.tier.String())
+			},
+		)
+	}
+	assert.Len(t, CompetitiveTiers, 26)
+	assert.Equal(t, CompetitiveTier(27), CompetitiveTierRadiant)
+}
@@ -0,0 +1,259 @@
+package val
+
+import (
+	"sort"
+	"strings"
+)
+
+// BuyType classifies how much a team invested into a round
+type BuyType string
+
+// All possible values of BuyType
+const (
+	BuyTypePistol BuyType = "pistol"
+	BuyTypeEco    BuyType = "eco"
+	BuyTypeForce  BuyType = "force"
+	BuyTypeFull   BuyType = "full"
+)
+
+// Average loadout values per player used to classify the buy type of a team
+const (
+	// ForceBuyLoadoutValue is the minimum average loadout value of a force buy
+	ForceBuyLoadoutValue = 2000
+	// FullBuyLoadoutValue is the minimum average loadout value of a full buy
+	FullBuyLoadoutValue = 3900
+)
+
+// Number of rounds in a half by mode. The first round of each half is a pistol round.
+const (
+	standardRoundsPerHalf  = 12
+	swiftplayRoundsPerHalf = 4
+	spikeRushRoundsPerHalf = 3
+)
+
+// Parts of the game mode of matches without queue, e.g. custom games, identifying the mode
+const (
+	gameModeSwiftplay = "Swiftplay"
+	gameModeSpikeRush = "QuickBomb"
+)
+
+// TeamEconomy contains the economy of a team in a round
+type TeamEconomy struct {
+	TeamID  string
+	BuyType BuyType
+	// LoadoutValue is the total value of the loadouts of all players of the team
+	LoadoutValue int
+	Spent        int
+	Remaining    int
+	Players      int
+}
+
+// AverageLoadoutValue returns the average loadout value per player
+func (e *TeamEconomy) AverageLoadoutValue() int {
+	if e.Players == 0 {
+		return 0
+	}
+	return e.LoadoutValue / e.Players
+}
+
+// WinRate counts the rounds played and won in a situation
+type WinRate struct {
+	Rounds int
+	Won    int
+}
+
+// Rate returns the fraction of rounds won between 0 and 1
+func (w WinRate) Rate() float64 {
+	if w.Rounds == 0 {
+		return 0
+	}
+	return float64(w.Won) / float64(w.Rounds)
+}
+
+func (w *WinRate) add(won bool) {
+	w.Rounds++
+	if won {
+		w.Won++
+	}
+}
+
+// RoundSummary summarizes a round for a timeline
+type RoundSummary struct {
+	RoundNum    int
+	WinningTeam string
+	ResultCode  string
+	// Economies contains the economy of each team by team ID
+	Economies    map[string]TeamEconomy
+	Kills        []Kill
+	BombPlanter  string
+	PlantSite    string
+	PlantTime    int
+	BombDefuser  string
+	DefuseTime   int
+	PlantingTeam string
+}
+
+// Planted returns whether the spike was planted in the round
+func (s *RoundSummary) Planted() bool {
+	return s.PlantSite != "" || s.BombPlanter != ""
+}
+
+// Converted returns whether the spike was planted and the planting team won the round
+func (s *RoundSummary) Converted() bool {
+	return s.Planted() && s.PlantingTeam == s.WinningTeam
+}
+
+// RoundTimeline contains the summaries of all rounds of a match in order
+type RoundTimeline struct {
+	MatchID string
+	MapID   string
+	Rounds  []RoundSummary
+}
+
+// GetRoundTimeline returns the summaries of all rounds of the match ordered by round number
+func (m *Match) GetRoundTimeline() RoundTimeline {
+	teams := m.playerTeams()
+	half := m.MatchInfo.roundsPerHalf()
+	res := RoundTimeline{
+		MatchID: m.MatchInfo.MatchID,
+		MapID:   m.MatchInfo.MapID,
+		Rounds:  make([]RoundSummary, 0, len(m.RoundResults)),
+	}
+	for i := range m.RoundResults {
+		round := &m.RoundResults[i]
+		res.Rounds = append(
+			res.Rounds, RoundSummary{
+				RoundNum:     round.RoundNum,
+				WinningTeam:  round.WinningTeam,
+				ResultCode:   round.RoundResultCode,
+				Economies:    round.teamEconomies(teams, half),
+				Kills:        round.GetKills(),
+				BombPlanter:  round.BombPlanter,
+				PlantSite:    round.PlantSite,
+				PlantTime:    round.PlantRoundTime,
+				BombDefuser:  round.BombDefuser,
+				DefuseTime:   round.DefuseRoundTime,
+				PlantingTeam: teams[round.BombPlanter],
+			},
+		)
+	}
+	sort.SliceStable(
+		res.Rounds, func(i, j int) bool {
+			return res.Rounds[i].RoundNum < res.Rounds[j].RoundNum
+		},
+	)
+	return res
+}
+
+// GetTeamEconomies returns the economy of each team by team ID for every round of the match, ordered as the
+// round results
+func (m *Match) GetTeamEconomies() []map[string]TeamEconomy {
+	teams := m.playerTeams()
+	half := m.MatchInfo.roundsPerHalf()
+	res := make([]map[string]TeamEconomy, 0, len(m.RoundResults))
+	for i := range m.RoundResults {
+		res = append(res, m.RoundResults[i].teamEconomies(teams, half))
+	}
+	return res
+}
+
+// GetBuyTypeWinRates returns the win rates of the team with the given ID by its buy type
+func (m *Match) GetBuyTypeWinRates(teamID string) map[BuyType]WinRate {
+	res := map[BuyType]WinRate{}
+	for _, round := range m.GetRoundTimeline().Rounds {
+		economy, ok := round.Economies[teamID]
+		if !ok {
+			continue
+		}
+		rate := res[economy.BuyType]
+		rate.add(round.WinningTeam == teamID)
+		res[economy.BuyType] = rate
+	}
+	return res
+}
+
+// GetSiteWinRates returns the win rates of the team with the given ID in rounds in which the spike was planted by
+// the site of the plant
+func (m *Match) GetSiteWinRates(teamID string) map[string]WinRate {
+	res := map[string]WinRate{}
+	for _, round := range m.GetRoundTimeline().Rounds {
+		if round.PlantSite == "" {
+			continue
+		}
+		rate := res[round.PlantSite]
+		rate.add(round.WinningTeam == teamID)
+		res[round.PlantSite] = rate
+	}
+	return res
+}
+
+// GetPostPlantConversion returns the rate at which the team with the given ID won rounds in which it planted the
+// spike. If teamID is empty, plants of all teams are counted.
+func (m *Match) GetPostPlantConversion(teamID string) WinRate {
+	var res WinRate
+	for _, round := range m.GetRoundTimeline().Rounds {
+		if !round.Planted() || (teamID != "" && round.PlantingTeam != teamID) {
+			continue
+		}
+		res.add(round.Converted())
+	}
+	return res
+}
+
+// teamEconomies sums up the economy of the players of each team in the round and classifies the buy types using
+// the given number of rounds per half
+func (r *RoundResult) teamEconomies(teams map[string]string, roundsPerHalf int) map[string]TeamEconomy {
+	res := map[string]TeamEconomy{}
+	for _, stats := range r.PlayerStats {
+		teamID, ok := teams[stats.PUUID]
+		if !ok {
+			continue
+		}
+		economy := res[teamID]
+		economy.TeamID = teamID
+		economy.LoadoutValue += stats.Economy.LoadOutValue
+		economy.Spent += stats.Economy.Spent
+		economy.Remaining += stats.Economy.Remaining
+		economy.Players++
+		res[teamID] = economy
+	}
+	for teamID, economy := range res {
+		economy.BuyType = classifyBuy(r.RoundNum, roundsPerHalf, economy.AverageLoadoutValue())
+		res[teamID] = economy
+	}
+	return res
+}
+
+// classifyBuy returns the buy type of a team in the round with the given number, starting at 0, of a match with
+// the given number of rounds per half. Overtime rounds are never pistol rounds.
+func classifyBuy(roundNum, roundsPerHalf, averageLoadoutValue int) BuyType {
+	switch {
+	case roundNum < 2*roundsPerHalf && roundNum%roundsPerHalf == 0:
+		return BuyTypePistol
+	case averageLoadoutValue >= FullBuyLoadoutValue:
+		return BuyTypeFull
+	case averageLoadoutValue >= ForceBuyLoadoutValue:
+		return BuyTypeForce
+	default:
+		return BuyTypeEco
+	}
+}
+
+// roundsPerHalf returns the number of rounds in a half of the match, identified by its queue or, if the match has
+// no queue, by its game mode. Modes other than swiftplay and spike rush use the number of a standard match.
+func (mi *MatchInfo) roundsPerHalf() int {
+	switch {
+	case mi.QueueID == QueueSwiftplay:
+		return swiftplayRoundsPerHalf
+	case mi.QueueID == QueueSpikeRush:
+		return spikeRushRoundsPerHalf
+	case mi.QueueID != "":
+		return standardRoundsPerHalf
+	case strings.Contains(mi.GameMode, gameModeSwiftplay):
+		return swiftplayRoundsPerHalf
+	case strings.Contains(mi.GameMode, gameModeSpikeRush):
+		return spikeRushRoundsPerHalf
+	default:
+		return standardRoundsPerHalf
+	}
+}
@@ -0,0 +1,205 @@
+package val
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEconomyMatch() *Match {
+	economy := func(puuid string, loadout, spent int) PlayerRoundStats {
+		return PlayerRoundStats{PUUID: puuid, Economy: Economy{LoadOutValue: loadout, Spent: spent, Remaining: 100}}
+	}
+	return &Match{
+		MatchInfo: MatchInfo{MatchID: "match", MapID: "/Game/Maps/Ascent/Ascent"},
+		Players: []MatchPlayer{
+			{PuuID: "a1", TeamID: "Blue"},
+			{PuuID: "a2", TeamID: "Blue"},
+			{PuuID: "b1", TeamID: "Red"},
+			{PuuID: "b2", TeamID: "Red"},
+		},
+		RoundResults: []RoundResult{
+			{
+				RoundNum:        2,
+				WinningTeam:     "Red",
+				RoundResultCode: RoundResultCodeElimination,
+				PlayerStats: []PlayerRoundStats{
+					economy("a1", 2500, 2000), economy("a2", 2100, 1800),
+					economy("b1", 4000, 3900), economy("b2", 4000, 3000),
+				},
+			},
+			{
+				RoundNum:        0,
+				WinningTeam:     "Blue",
+				RoundResultCode: RoundResultCodeDetonate,
+				BombPlanter:     "a1",
+				PlantSite:       "A",
+				PlantRoundTime:  40000,
+				PlayerStats: []PlayerRoundStats{
+					economy("a1", 800, 800), economy("a2", 800, 800),
+					economy("b1", 800, 800), economy("b2", 800, 800),
+				},
+			},
+			{
+				RoundNum:        1,
+				WinningTeam:     "Red",
+				RoundResultCode: RoundResultCodeDefuse,
+				BombPlanter:     "a2",
+				BombDefuser:     "b1",
+				PlantSite:       "B",
+				PlayerStats: []PlayerRoundStats{
+					economy("a1", 4000, 3000), economy("a2", 4200, 3100),
+					economy("b1", 500, 0), economy("b2", 800, 300),
+				},
+			},
+		},
+	}
+}
+
+func TestMatch_GetRoundTimeline(t *testing.T) {
+	t.Parallel()
+	timeline := testEconomyMatch().GetRoundTimeline()
+	assert.Equal(t, "match", timeline.MatchID)
+	assert.Equal(t, "/Game/Maps/Ascent/Ascent", timeline.MapID)
+	require.Len(t, timeline.Rounds, 3)
+	for i, round := range timeline.Rounds {
+		assert.Equal(t, i, round.RoundNum)
+	}
+	first := timeline.Rounds[0]
+	assert.True(t, first.Planted())
+	assert.True(t, first.Converted())
+	assert.Equal(t, "Blue", first.PlantingTeam)
+	assert.Equal(t, 40000, first.PlantTime)
+	assert.Equal(
+		t, TeamEconomy{
+			TeamID: "Blue", BuyType: BuyTypePistol, LoadoutValue: 1600, Spent: 1600, Remaining: 200, Players: 2,
+		}, first.Economies["Blue"],
+	)
+	second := timeline.Rounds[1]
+	assert.True(t, second.Planted())
+	assert.False(t, second.Converted())
+	assert.Equal(t, "b1", second.BombDefuser)
+	assert.Equal(t, BuyTypeFull, second.Economies["Blue"].BuyType)
+	assert.Equal(t, BuyTypeEco, second.Economies["Red"].BuyType)
+	third := timeline.Rounds[2]
+	assert.False(t, third.Planted())
+	assert.False(t, third.Converted())
+	assert.Equal(t, BuyTypeForce, third.Economies["Blue"].BuyType)
+	assert.Equal(t, 2300, (&TeamEconomy{LoadoutValue: 4600, Players: 2}).AverageLoadoutValue())
+	assert.Zero(t, (&TeamEconomy{}).AverageLoadoutValue())
+}
+
+func TestMatch_GetTeamEconomies(t *testing.T) {
+	t.Parallel()
+	economies := testEconomyMatch().GetTeamEconomies()
+	require.Len(t, economies, 3)
+	assert.Equal(t, BuyTypeFull, economies[0]["Red"].BuyType)
+	assert.Equal(t, BuyTypePistol, economies[1]["Red"].BuyType)
+}
+
+func TestMatch_GetBuyTypeWinRates(t *testing.T) {
+	t.Parallel()
+	m := testEconomyMatch()
+	assert.Equal(
+		t, map[BuyType]WinRate{
+			BuyTypePistol: {Rounds: 1, Won: 1},
+			BuyTypeFull:   {Rounds: 1},
+			BuyTypeForce:  {Rounds: 1},
+		}, m.GetBuyTypeWinRates("Blue"),
+	)
+	assert.Equal(
+		t, map[BuyType]WinRate{
+			BuyTypePistol: {Rounds: 1},
+			BuyTypeEco:    {Rounds: 1, Won: 1},
+			BuyTypeFull:   {Rounds: 1, Won: 1},
+		}, m.GetBuyTypeWinRates("Red"),
+	)
+}
+
+func TestMatch_GetSiteWinRates(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t, map[string]WinRate{"A": {Rounds: 1, Won: 1}, "B": {Rounds: 1}}, testEconomyMatch().GetSiteWinRates("Blue"),
+	)
+}
+
+func TestMatch_GetPostPlantConversion(t *testing.T) {
+	t.Parallel()
+	m := testEconomyMatch()
+	conversion := m.GetPostPlantConversion("Blue")
+	assert.Equal(t, WinRate{Rounds: 2, Won: 1}, conversion)
+	assert.Equal(t, 0.5, conversion.Rate())
+	assert.Equal(t, WinRate{Rounds: 2, Won: 1}, m.GetPostPlantConversion(""))
+	assert.Equal(t, WinRate{}, m.GetPostPlantConversion("Red"))
+	assert.Zero(t, WinRate{}.Rate())
+}
+
+func TestClassifyBuy(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		roundNum int
+		half     int
+		loadout  int
+		want     BuyType
+	}{
+		{roundNum: 0, half: standardRoundsPerHalf, loadout: 5000, want: BuyTypePistol},
+		{roundNum: 12, half: standardRoundsPerHalf, loadout: 800, want: BuyTypePistol},
+		{roundNum: 24, half: standardRoundsPerHalf, loadout: 800, want: BuyTypeEco},
+		{roundNum: 4, half: standardRoundsPerHalf, loadout: 800, want: BuyTypeEco},
+		{roundNum: 4, half: swiftplayRoundsPerHalf, loadout: 800, want: BuyTypePistol},
+		{roundNum: 8, half: swiftplayRoundsPerHalf, loadout: 800, want: BuyTypeEco},
+		{roundNum: 3, half: spikeRushRoundsPerHalf, loadout: 5000, want: BuyTypePistol},
+		{roundNum: 1, half: standardRoundsPerHalf, loadout: 1999, want: BuyTypeEco},
+		{roundNum: 1, half: standardRoundsPerHalf, loadout: 2000, want: BuyTypeForce},
+		{roundNum: 1, half: standardRoundsPerHalf, loadout: 3900, want: BuyTypeFull},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, classifyBuy(tt.roundNum, tt.half, tt.loadout))
+	}
+}
+
+func TestMatchInfo_roundsPerHalf(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		info MatchInfo
+		want int
+	}{
+		{name: "competitive", info: MatchInfo{QueueID: QueueCompetitive}, want: standardRoundsPerHalf},
+		{name: "swiftplay", info: MatchInfo{QueueID: QueueSwiftplay}, want: swiftplayRoundsPerHalf},
+		{name: "spike rush", info: MatchInfo{QueueID: QueueSpikeRush}, want: spikeRushRoundsPerHalf},
+		{
+			name: "custom spike rush",
+			info: MatchInfo{GameMode: "/Game/GameModes/QuickBomb/QuickBombGameMode.QuickBombGameMode_C"},
+			want: spikeRushRoundsPerHalf,
+		},
+		{
+			name: "custom swiftplay",
+			info: MatchInfo{GameMode: "/Game/GameModes/Swiftplay/SwiftplayGameMode.SwiftplayGameMode_C"},
+			want: swiftplayRoundsPerHalf,
+		},
+		{
+			name: "custom standard",
+			info: MatchInfo{GameMode: "/Game/GameModes/Bomb/BombGameMode.BombGameMode_C"},
+			want: standardRoundsPerHalf,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.want, tt.info.roundsPerHalf())
+			},
+		)
+	}
+}
+
+func TestMatch_GetTeamEconomiesSwiftplay(t *testing.T) {
+	t.Parallel()
+	m := testEconomyMatch()
+	m.MatchInfo.QueueID = QueueSwiftplay
+	m.RoundResults[0].RoundNum = 4
+	economies := m.GetTeamEconomies()
+	assert.Equal(t, BuyTypePistol, economies[0]["Red"].BuyType)
+	assert.Equal(t, BuyTypePistol, m.GetRoundTimeline().Rounds[2].Economies["Blue"].BuyType)
+}
@@ -0,0 +1,294 @@
+package val
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/KnutZuidema/golio/api"
+)
+
+// maxLeaderboardPageSize is the maximum number of players returned by a single leaderboard request
+const maxLeaderboardPageSize = 200
+
+// LeaderboardStreamValue value returned by LeaderboardStream, containing either a player or an error
+type LeaderboardStreamValue struct {
+	Player *Player
+	Error  error
+}
+
+// LeaderboardStream returns all players of the leaderboard of the given act as a stream, requesting new pages
+// until the end of the leaderboard is reached. Once ctx is done, no more pages are requested and the stream is
+// closed, so callers can stop reading early by cancelling ctx.
+func (cc *RankedClient) LeaderboardStream(ctx context.Context, actID string) <-chan LeaderboardStreamValue {
+	logger := cc.logger().WithField("method", "LeaderboardStream")
+	cPlayers := make(chan LeaderboardStreamValue, maxLeaderboardPageSize)
+	send := func(value LeaderboardStreamValue) bool {
+		select {
+		case cPlayers <- value:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go func() {
+		defer close(cPlayers)
+		err := cc.eachLeaderboardPage(
+			actID, func(page *Leaderboard) bool {
+				for _, player := range page.Players {
+					if !send(LeaderboardStreamValue{Player: player}) {
+						return false
+					}
+				}
+				return ctx.Err() == nil
+			},
+		)
+		if err != nil {
+			logger.Debug(err)
+			send(LeaderboardStreamValue{Error: err})
+		}
+	}()
+	return cPlayers
+}
+
+// GetFullLeaderboard returns the complete leaderboard of the given act, e.g. to take a snapshot for
+// DiffLeaderboards
+func (cc *RankedClient) GetFullLeaderboard(actID string) (*Leaderboard, error) {
+	var res *Leaderboard
+	err := cc.eachLeaderboardPage(
+		actID, func(page *Leaderboard) bool {
+			if res == nil {
+				res = &Leaderboard{Shard: page.Shard, ActID: page.ActID}
+			}
+			res.TotalPlayers = page.TotalPlayers
+			res.Players = append(res.Players, page.Players...)
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindPlayer returns the leaderboard entry of the player with the given PUUID in the given act. Pages are only
+// requested until the player is found.
+func (cc *RankedClient) FindPlayer(actID, puuid string) (*Player, error) {
+	return cc.findPlayer(
+		actID, func(page *Leaderboard) *Player {
+			return page.findPlayer(puuid)
+		},
+	)
+}
+
+// FindPlayerByRiotID returns the leaderboard entry of the player with the given Riot ID of the form
+// GameName#TagLine in the given act. Players who chose to be anonymous have neither PUUID nor Riot ID in the
+// leaderboard and can not be found.
+func (cc *RankedClient) FindPlayerByRiotID(actID, riotID string) (*Player, error) {
+	return cc.findPlayer(
+		actID, func(page *Leaderboard) *Player {
+			return page.findPlayerByRiotID(riotID)
+		},
+	)
+}
+
+func (cc *RankedClient) findPlayer(actID string, find func(*Leaderboard) *Player) (*Player, error) {
+	var res *Player
+	err := cc.eachLeaderboardPage(
+		actID, func(page *Leaderboard) bool {
+			res = find(page)
+			return res == nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, api.ErrNotFound
+	}
+	return res, nil
+}
+
+// eachLeaderboardPage calls fn for each page of the leaderboard until fn returns false or the end of the
+// leaderboard is reached
+func (cc *RankedClient) eachLeaderboardPage(actID string, fn func(*Leaderboard) bool) error {
+	var start int32
+	for {
+		page, err := cc.GetLeaderboardByActID(actID, start, maxLeaderboardPageSize)
+		if err != nil {
+			return err
+		}
+		if !fn(page) || len(page.Players) < maxLeaderboardPageSize {
+			return nil
+		}
+		start += maxLeaderboardPageSize
+		if page.TotalPlayers > 0 && int64(start) >= page.TotalPlayers {
+			return nil
+		}
+	}
+}
+
+// GetCurrentAct returns the currently active act
+func (c *Client) GetCurrentAct() (*Act, error) {
+	idx, err := c.Content.GetContentIndex(LocaleUnitedStates)
+	if err != nil {
+		return nil, err
+	}
+	return idx.ActiveAct()
+}
+
+// CurrentLeaderboardStream returns all players of the leaderboard of the currently active act as a stream. See
+// LeaderboardStream for the cancellation by ctx.
+func (c *Client) CurrentLeaderboardStream(ctx context.Context) <-chan LeaderboardStreamValue {
+	act, err := c.GetCurrentAct()
+	if err != nil {
+		cPlayers := make(chan LeaderboardStreamValue, 1)
+		cPlayers <- LeaderboardStreamValue{Error: err}
+		close(cPlayers)
+		return cPlayers
+	}
+	return c.Ranked.LeaderboardStream(ctx, act.ID)
+}
+
+// GetCurrentLeaderboard returns the complete leaderboard of the currently active act
+func (c *Client) GetCurrentLeaderboard() (*Leaderboard, error) {
+	act, err := c.GetCurrentAct()
+	if err != nil {
+		return nil, err
+	}
+	return c.Ranked.GetFullLeaderboard(act.ID)
+}
+
+// FindPlayer returns the entry of the player with the given PUUID in the leaderboard
+func (l *Leaderboard) FindPlayer(puuid string) (*Player, error) {
+	if player := l.findPlayer(puuid); player != nil {
+		return player, nil
+	}
+	return nil, api.ErrNotFound
+}
+
+// FindPlayerByRiotID returns the entry of the player with the given Riot ID of the form GameName#TagLine in the
+// leaderboard. The Riot ID is matched ignoring case.
+func (l *Leaderboard) FindPlayerByRiotID(riotID string) (*Player, error) {
+	if player := l.findPlayerByRiotID(riotID); player != nil {
+		return player, nil
+	}
+	return nil, api.ErrNotFound
+}
+
+func (l *Leaderboard) findPlayer(puuid string) *Player {
+	for _, player := range l.Players {
+		if player.PuuID == puuid && puuid != "" {
+			return player
+		}
+	}
+	return nil
+}
+
+func (l *Leaderboard) findPlayerByRiotID(riotID string) *Player {
+	i := strings.LastIndex(riotID, "#")
+	if i < 0 {
+		return nil
+	}
+	gameName, tagLine := strings.TrimSpace(riotID[:i]), strings.TrimSpace(riotID[i+1:])
+	for _, player := range l.Players {
+		if player.GameName != "" && strings.EqualFold(player.GameName, gameName) &&
+			strings.EqualFold(player.TagLine, tagLine) {
+			return player
+		}
+	}
+	return nil
+}
+
+// RankChange describes the movement of a player between two leaderboard snapshots. A rank of 0 means the player
+// was not part of the snapshot.
+type RankChange struct {
+	PuuID        string
+	GameName     string
+	TagLine      string
+	OldRank      int64
+	NewRank      int64
+	OldRating    int64
+	NewRating    int64
+	OldTier      CompetitiveTier
+	NewTier      CompetitiveTier
+	NumberOfWins int64
+}
+
+// Movement returns the number of places the player climbed. Negative values mean the player dropped.
+func (c *RankChange) Movement() int64 {
+	if c.OldRank == 0 || c.NewRank == 0 {
+		return 0
+	}
+	return c.OldRank - c.NewRank
+}
+
+// RatingChange returns the change of the ranked rating of the player
+func (c *RankChange) RatingChange() int64 {
+	return c.NewRating - c.OldRating
+}
+
+// Entered returns whether the player is only part of the new snapshot
+func (c *RankChange) Entered() bool {
+	return c.OldRank == 0 && c.NewRank != 0
+}
+
+// Left returns whether the player is only part of the old snapshot
+func (c *RankChange) Left() bool {
+	return c.OldRank != 0 && c.NewRank == 0
+}
+
+// DiffLeaderboards compares two snapshots of a leaderboard and returns the changes of all players whose rank or
+// rating changed, including players who entered or left the leaderboard. Players are matched by PUUID and
+// the changes are ordered by new rank, followed by players who left ordered by their old rank.
+// Anonymous players have no PUUID and can not be matched between snapshots, so they are skipped.
+func DiffLeaderboards(previous, current *Leaderboard) []RankChange {
+	changes := map[string]*RankChange{}
+	for _, player := range previous.Players {
+		if player.PuuID == "" {
+			continue
+		}
+		changes[player.PuuID] = &RankChange{
+			PuuID:     player.PuuID,
+			GameName:  player.GameName,
+			TagLine:   player.TagLine,
+			OldRank:   player.LeaderboardRank,
+			OldRating: player.RankedRating,
+			OldTier:   player.CompetitiveTier,
+		}
+	}
+	for _, player := range current.Players {
+		if player.PuuID == "" {
+			continue
+		}
+		change, ok := changes[player.PuuID]
+		if !ok {
+			change = &RankChange{PuuID: player.PuuID}
+			changes[player.PuuID] = change
+		}
+		change.GameName = player.GameName
+		change.TagLine = player.TagLine
+		change.NewRank = player.LeaderboardRank
+		change.NewRating = player.RankedRating
+		change.NewTier = player.CompetitiveTier
+		change.NumberOfWins = player.NumberOfWins
+	}
+	res := make([]RankChange, 0, len(changes))
+	for _, change := range changes {
+		if change.OldRank != change.NewRank || change.OldRating != change.NewRating {
+			res = append(res, *change)
+		}
+	}
+	sort.Slice(
+		res, func(i, j int) bool {
+			if (res[i].NewRank == 0) != (res[j].NewRank == 0) {
+				return res[j].NewRank == 0
+			}
+			if res[i].NewRank != res[j].NewRank {
+				return res[i].NewRank < res[j].NewRank
+			}
+			return res[i].OldRank < res[j].OldRank
+		},
+	)
+	return res
+}
@@ -0,0 +1,213 @@
+package val
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// leaderboardDoer serves a leaderboard of the given number of players for the act "act" and the content of
+// testContentInfo. The requested start indices are recorded.
+type leaderboardDoer struct {
+	total  int
+	starts []int
+}
+
+func (d *leaderboardDoer) Do(r *http.Request) (*http.Response, error) {
+	if strings.Contains(r.URL.Path, "/contents") {
+		return mock.NewJSONMockDoer(testContentInfo("release-08.00"), http.StatusOK).Do(r)
+	}
+	if !strings.HasSuffix(r.URL.Path, "/leaderboards/by-act/act") {
+		return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+	}
+	start, _ := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+	d.starts = append(d.starts, start)
+	page := Leaderboard{ActID: "act", Shard: "eu", TotalPlayers: int64(d.total)}
+	for i := start; i < start+size && i < d.total; i++ {
+		page.Players = append(page.Players, testLeaderboardPlayer(i+1))
+	}
+	return mock.NewJSONMockDoer(page, http.StatusOK).Do(r)
+}
+
+func testLeaderboardPlayer(rank int) *Player {
+	return &Player{
+		PuuID:           fmt.Sprintf("puuid-%d", rank),
+		GameName:        fmt.Sprintf("Player %d", rank),
+		TagLine:         "EUW",
+		LeaderboardRank: int64(rank),
+		RankedRating:    int64(1000 - rank),
+		CompetitiveTier: CompetitiveTierRadiant,
+	}
+}
+
+func newLeaderboardClient(doer internal.Doer) *Client {
+	return NewClient(internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger()))
+}
+
+func TestRankedClient_LeaderboardStream(t *testing.T) {
+	t.Parallel()
+	doer := &leaderboardDoer{total: 450}
+	client := newLeaderboardClient(doer)
+	var players []*Player
+	for value := range client.Ranked.LeaderboardStream(context.Background(), "act") {
+		require.Nil(t, value.Error)
+		players = append(players, value.Player)
+	}
+	require.Len(t, players, 450)
+	assert.Equal(t, int64(450), players[449].LeaderboardRank)
+	assert.Equal(t, []int{0, 200, 400}, doer.starts)
+
+	var errs []error
+	for value := range client.Ranked.LeaderboardStream(context.Background(), "unknown") {
+		errs = append(errs, value.Error)
+	}
+	assert.Equal(t, []error{api.ErrNotFound}, errs)
+}
+
+func TestRankedClient_LeaderboardStreamCancel(t *testing.T) {
+	t.Parallel()
+	doer := &leaderboardDoer{total: 1000}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newLeaderboardClient(doer).Ranked.LeaderboardStream(ctx, "act")
+	<-stream
+	cancel()
+	timeout := time.After(time.Second)
+	for done := false; !done; {
+		select {
+		case _, ok := <-stream:
+			done = !ok
+		case <-timeout:
+			require.FailNow(t, "stream was not closed after cancellation")
+		}
+	}
+	assert.Less(t, len(doer.starts), 5)
+}
+
+func TestRankedClient_GetFullLeaderboard(t *testing.T) {
+	t.Parallel()
+	doer := &leaderboardDoer{total: 400}
+	client := newLeaderboardClient(doer)
+	leaderboard, err := client.Ranked.GetFullLeaderboard("act")
+	require.Nil(t, err)
+	assert.Len(t, leaderboard.Players, 400)
+	assert.Equal(t, int64(400), leaderboard.TotalPlayers)
+	assert.Equal(t, "eu", leaderboard.Shard)
+	assert.Equal(t, []int{0, 200}, doer.starts)
+	_, err = client.Ranked.GetFullLeaderboard("unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestRankedClient_FindPlayer(t *testing.T) {
+	t.Parallel()
+	doer := &leaderboardDoer{total: 450}
+	client := newLeaderboardClient(doer)
+	player, err := client.Ranked.FindPlayer("act", "puuid-250")
+	require.Nil(t, err)
+	assert.Equal(t, int64(250), player.LeaderboardRank)
+	assert.Equal(t, []int{0, 200}, doer.starts)
+	player, err = client.Ranked.FindPlayerByRiotID("act", "player 3#euw")
+	require.Nil(t, err)
+	assert.Equal(t, "puuid-3", player.PuuID)
+	_, err = client.Ranked.FindPlayer("act", "unknown")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = client.Ranked.FindPlayerByRiotID("unknown", "Player 3#EUW")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestClient_CurrentLeaderboard(t *testing.T) {
+	t.Parallel()
+	client := newLeaderboardClient(&leaderboardDoer{total: 3})
+	act, err := client.GetCurrentAct()
+	require.Nil(t, err)
+	assert.Equal(t, "act", act.ID)
+	leaderboard, err := client.GetCurrentLeaderboard()
+	require.Nil(t, err)
+	assert.Len(t, leaderboard.Players, 3)
+	var players int
+	for value := range client.CurrentLeaderboardStream(context.Background()) {
+		require.Nil(t, value.Error)
+		players++
+	}
+	assert.Equal(t, 3, players)
+
+	client = newLeaderboardClient(mock.NewStatusMockDoer(http.StatusForbidden))
+	_, err = client.GetCurrentLeaderboard()
+	assert.Equal(t, api.ErrForbidden, err)
+	var errs []error
+	for value := range client.CurrentLeaderboardStream(context.Background()) {
+		errs = append(errs, value.Error)
+	}
+	assert.Equal(t, []error{api.ErrForbidden}, errs)
+}
+
+func TestLeaderboard_FindPlayer(t *testing.T) {
+	t.Parallel()
+	leaderboard := &Leaderboard{
+		Players: []*Player{testLeaderboardPlayer(1), {PuuID: "anonymous", LeaderboardRank: 2}},
+	}
+	player, err := leaderboard.FindPlayer("anonymous")
+	require.Nil(t, err)
+	assert.Equal(t, int64(2), player.LeaderboardRank)
+	_, err = leaderboard.FindPlayer("")
+	assert.Equal(t, api.ErrNotFound, err)
+	player, err = leaderboard.FindPlayerByRiotID(" PLAYER 1 # euw ")
+	require.Nil(t, err)
+	assert.Equal(t, "puuid-1", player.PuuID)
+	_, err = leaderboard.FindPlayerByRiotID("#")
+	assert.Equal(t, api.ErrNotFound, err)
+	_, err = leaderboard.FindPlayerByRiotID("Player 1")
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestDiffLeaderboards(t *testing.T) {
+	t.Parallel()
+	previous := &Leaderboard{
+		Players: []*Player{
+			{PuuID: "a", LeaderboardRank: 1, RankedRating: 900},
+			{PuuID: "b", LeaderboardRank: 2, RankedRating: 850},
+			{PuuID: "c", LeaderboardRank: 3, RankedRating: 800},
+			{PuuID: "d", LeaderboardRank: 4, RankedRating: 790},
+			{LeaderboardRank: 5, RankedRating: 780},
+			{LeaderboardRank: 6, RankedRating: 770},
+		},
+	}
+	current := &Leaderboard{
+		Players: []*Player{
+			{PuuID: "b", LeaderboardRank: 1, RankedRating: 920},
+			{PuuID: "a", LeaderboardRank: 2, RankedRating: 900},
+			{PuuID: "c", LeaderboardRank: 3, RankedRating: 800},
+			{PuuID: "e", LeaderboardRank: 4, RankedRating: 795, GameName: "New"},
+			{LeaderboardRank: 5, RankedRating: 785},
+			{LeaderboardRank: 6, RankedRating: 760},
+			{LeaderboardRank: 7, RankedRating: 750},
+		},
+	}
+	changes := DiffLeaderboards(previous, current)
+	require.Len(t, changes, 4)
+	puuids := make([]string, 0, len(changes))
+	for _, change := range changes {
+		puuids = append(puuids, change.PuuID)
+	}
+	assert.Equal(t, []string{"b", "a", "e", "d"}, puuids)
+	assert.Equal(t, int64(1), changes[0].Movement())
+	assert.Equal(t, int64(70), changes[0].RatingChange())
+	assert.Equal(t, int64(-1), changes[1].Movement())
+	assert.True(t, changes[2].Entered())
+	assert.Equal(t, "New", changes[2].GameName)
+	assert.Equal(t, int64(0), changes[2].Movement())
+	assert.True(t, changes[3].Left())
+	assert.False(t, changes[3].Entered())
+}
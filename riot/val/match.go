@@ -5,20 +5,26 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/riot/account"
 )
 
 // MatchClient provides methods for the match endpoints of the VALORANT API.
 type MatchClient struct {
 	c *internal.Client
+	// shards is used to route player-scoped requests to the active shard of the player if set
+	shards *account.ShardCache
 }
 
-// GetMatchByID returns information about a match using match id
+// GetMatchByID returns information about a match using match id. The match is requested from the region of the
+// client, use ForPlayer to request a match of a player on another shard.
 func (cc *MatchClient) GetMatchByID(matchID string) (*Match, error) {
 	logger := cc.logger().WithField("method", "GetMatchByID")
 	url := endpointMatchByID
 	var match *Match
-	if err := cc.c.GetInto(fmt.Sprintf(url, matchID), &match); err != nil {
+	c := cc.c.ForGame(api.GameVAL, api.FamilyPlatform)
+	if err := c.GetInto(fmt.Sprintf(url, matchID), &match); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -31,7 +37,13 @@ func (cc *MatchClient) GetMatchListByPUUID(puuid string) (*MatchList, error) {
 	logger := cc.logger().WithField("method", "GetMatchListByPUUID")
 	url := endpointMatchListByPUUID
 	var matchList *MatchList
-	if err := cc.c.GetInto(fmt.Sprintf(url, puuid), &matchList); err != nil {
+	mc, err := cc.ForPlayer(puuid)
+	if err != nil {
+		logger.Debug(err)
+		return nil, err
+	}
+	c := mc.c.ForGame(api.GameVAL, api.FamilyPlatform)
+	if err = c.GetInto(fmt.Sprintf(url, puuid), &matchList); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -44,7 +56,8 @@ func (cc *MatchClient) GetRecentMatchesByQueue(queue string) (*RecentMatches, er
 	logger := cc.logger().WithField("method", "GetRecentMatchesByQueue")
 	url := endpointRecentMatchesByQueue
 	var recentMatches *RecentMatches
-	if err := cc.c.GetInto(fmt.Sprintf(url, queue), &recentMatches); err != nil {
+	c := cc.c.ForGame(api.GameVAL, api.FamilyPlatform)
+	if err := c.GetInto(fmt.Sprintf(url, queue), &recentMatches); err != nil {
 		logger.Debug(err)
 		fmt.Println(err)
 		return nil, err
@@ -52,6 +65,22 @@ func (cc *MatchClient) GetRecentMatchesByQueue(queue string) (*RecentMatches, er
 	return recentMatches, nil
 }
 
+// ForPlayer returns a client sending requests to the active shard of the player with the given PUUID, or the
+// client itself if active shards are not used. Use it to request matches of the player with GetMatchByID or
+// MatchStream.
+func (cc *MatchClient) ForPlayer(puuid string) (*MatchClient, error) {
+	if cc.shards == nil {
+		return cc, nil
+	}
+	shard, err := cc.shards.ActiveShard(account.GameValorant, puuid)
+	if err != nil {
+		return nil, err
+	}
+	c := *cc.c
+	c.Region = shard
+	return &MatchClient{c: &c}, nil
+}
+
 func (cc *MatchClient) logger() log.FieldLogger {
 	return cc.c.Logger().WithField("category", "match")
 }
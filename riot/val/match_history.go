@@ -0,0 +1,129 @@
+package val
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// defaultMatchConcurrency is the number of matches requested in parallel if no concurrency is given
+const defaultMatchConcurrency = 4
+
+// MatchListOptions providing additional options for filtering a match list. The VALORANT API does not support
+// filters, so they are applied after requesting the match list.
+type MatchListOptions struct {
+	// Queues filters the matches by queue, e.g. QueueCompetitive. Matches of any of the given queues are kept.
+	Queues []string
+	// StartTime and EndTime filter the matches by the time they started. Zero values are ignored.
+	StartTime, EndTime time.Time
+}
+
+// Matches returns whether the match list entry satisfies all filters
+func (mo *MatchListOptions) Matches(entry *MatchListEntry) bool {
+	if mo == nil {
+		return true
+	}
+	if len(mo.Queues) > 0 && !slices.Contains(mo.Queues, entry.QueueID) {
+		return false
+	}
+	started := time.UnixMilli(entry.GameStartTimeMillis)
+	if !mo.StartTime.IsZero() && started.Before(mo.StartTime) {
+		return false
+	}
+	return mo.EndTime.IsZero() || started.Before(mo.EndTime)
+}
+
+// Filter returns the entries of the match history satisfying all filters of the given options
+func (ml *MatchList) Filter(options *MatchListOptions) []MatchListEntry {
+	res := make([]MatchListEntry, 0, len(ml.History))
+	for i := range ml.History {
+		if options.Matches(&ml.History[i]) {
+			res = append(res, ml.History[i])
+		}
+	}
+	return res
+}
+
+// ListMatches returns the entries of the match history of the player with the given PUUID satisfying all filters
+// of the given options
+func (cc *MatchClient) ListMatches(puuid string, options *MatchListOptions) ([]MatchListEntry, error) {
+	matchList, err := cc.GetMatchListByPUUID(puuid)
+	if err != nil {
+		return nil, err
+	}
+	return matchList.Filter(options), nil
+}
+
+// MatchStreamValue value returned by MatchStream, containing either a match or an error for the match ID
+type MatchStreamValue struct {
+	MatchID string
+	Match   *Match
+	Error   error
+}
+
+// MatchStream requests the matches with the given IDs, at most concurrency at a time, and returns them as a
+// stream in the order they were received. If concurrency is less than 1, 4 matches are requested at a time.
+// The matches are requested from the region of the client, see ForPlayer. Once ctx is done, no more matches are
+// requested and the stream is closed, so callers can stop reading early by cancelling ctx.
+func (cc *MatchClient) MatchStream(ctx context.Context, matchIDs []string, concurrency int) <-chan MatchStreamValue {
+	logger := cc.logger().WithField("method", "MatchStream")
+	if concurrency < 1 {
+		concurrency = defaultMatchConcurrency
+	}
+	cMatches := make(chan MatchStreamValue, concurrency)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	go func() {
+		defer close(cMatches)
+		defer wg.Wait()
+		for _, matchID := range matchIDs {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func(matchID string) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				match, err := cc.GetMatchByID(matchID)
+				if err != nil {
+					logger.Debug(err)
+				}
+				select {
+				case cMatches <- MatchStreamValue{MatchID: matchID, Match: match, Error: err}:
+				case <-ctx.Done():
+				}
+			}(matchID)
+		}
+	}()
+	return cMatches
+}
+
+// MatchHistoryStream requests all matches of the player with the given PUUID satisfying the filters of the
+// given options, at most concurrency at a time, and returns them as a stream in the order they were received.
+// If active shards are used, the matches are requested from the active shard of the player. See MatchStream for
+// the cancellation by ctx.
+func (cc *MatchClient) MatchHistoryStream(
+	ctx context.Context, puuid string, options *MatchListOptions, concurrency int,
+) <-chan MatchStreamValue {
+	mc, err := cc.ForPlayer(puuid)
+	var entries []MatchListEntry
+	if err == nil {
+		entries, err = mc.ListMatches(puuid, options)
+	}
+	if err != nil {
+		cMatches := make(chan MatchStreamValue, 1)
+		cMatches <- MatchStreamValue{Error: err}
+		close(cMatches)
+		return cMatches
+	}
+	matchIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		matchIDs = append(matchIDs, entry.MatchID)
+	}
+	return mc.MatchStream(ctx, matchIDs, concurrency)
+}
@@ -0,0 +1,190 @@
+package val
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// matchDoer serves the match list testMatchList for any player and a match for any match ID except "missing". It
+// records the number of match requests and the maximum number of concurrent match requests.
+type matchDoer struct {
+	mu       sync.Mutex
+	active   int
+	maxSeen  int
+	requests int
+}
+
+func (d *matchDoer) Do(r *http.Request) (*http.Response, error) {
+	if strings.Contains(r.URL.Path, "/matchlists/by-puuid/") {
+		return mock.NewJSONMockDoer(testMatchList, http.StatusOK).Do(r)
+	}
+	matchID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	d.mu.Lock()
+	d.active++
+	d.requests++
+	d.maxSeen = max(d.maxSeen, d.active)
+	d.mu.Unlock()
+	time.Sleep(5 * time.Millisecond)
+	d.mu.Lock()
+	d.active--
+	d.mu.Unlock()
+	if matchID == "missing" {
+		return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+	}
+	return mock.NewJSONMockDoer(Match{MatchInfo: MatchInfo{MatchID: matchID}}, http.StatusOK).Do(r)
+}
+
+var testMatchList = MatchList{
+	PUUID: "puuid",
+	History: []MatchListEntry{
+		{MatchID: "m1", GameStartTimeMillis: 1000, QueueID: QueueCompetitive},
+		{MatchID: "m2", GameStartTimeMillis: 2000, QueueID: QueueUnrated},
+		{MatchID: "m3", GameStartTimeMillis: 3000, QueueID: QueueCompetitive},
+		{MatchID: "m4", GameStartTimeMillis: 4000, QueueID: QueueDeathmatch},
+	},
+}
+
+func newMatchClient(doer internal.Doer) *MatchClient {
+	return &MatchClient{c: internal.NewClient(api.RegionEuropeWest, "API_KEY", doer, logrus.StandardLogger())}
+}
+
+func matchIDs(entries []MatchListEntry) []string {
+	res := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		res = append(res, entry.MatchID)
+	}
+	return res
+}
+
+func TestMatchList_Filter(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		options *MatchListOptions
+		want    []string
+	}{
+		{
+			name: "no options",
+			want: []string{"m1", "m2", "m3", "m4"},
+		},
+		{
+			name:    "queues",
+			options: &MatchListOptions{Queues: []string{QueueCompetitive, QueueDeathmatch}},
+			want:    []string{"m1", "m3", "m4"},
+		},
+		{
+			name:    "time window",
+			options: &MatchListOptions{StartTime: time.UnixMilli(2000), EndTime: time.UnixMilli(4000)},
+			want:    []string{"m2", "m3"},
+		},
+		{
+			name: "queue and start time",
+			options: &MatchListOptions{
+				Queues:    []string{QueueCompetitive},
+				StartTime: time.UnixMilli(1500),
+			},
+			want: []string{"m3"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(
+			test.name, func(t *testing.T) {
+				t.Parallel()
+				assert.Equal(t, test.want, matchIDs(testMatchList.Filter(test.options)))
+			},
+		)
+	}
+}
+
+func TestMatchClient_ListMatches(t *testing.T) {
+	t.Parallel()
+	client := newMatchClient(&matchDoer{})
+	got, err := client.ListMatches("puuid", &MatchListOptions{Queues: []string{QueueUnrated}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m2"}, matchIDs(got))
+
+	_, err = newMatchClient(mock.NewStatusMockDoer(http.StatusNotFound)).ListMatches("puuid", nil)
+	assert.Equal(t, api.ErrNotFound, err)
+}
+
+func TestMatchClient_MatchStream(t *testing.T) {
+	t.Parallel()
+	doer := &matchDoer{}
+	client := newMatchClient(doer)
+	ids := []string{"a", "b", "c", "missing", "d", "e", "f"}
+	var got []string
+	var failed []string
+	for value := range client.MatchStream(context.Background(), ids, 2) {
+		if value.Error != nil {
+			failed = append(failed, value.MatchID)
+			continue
+		}
+		require.NotNil(t, value.Match)
+		assert.Equal(t, value.MatchID, value.Match.MatchInfo.MatchID)
+		got = append(got, value.MatchID)
+	}
+	sort.Strings(got)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e", "f"}, got)
+	assert.Equal(t, []string{"missing"}, failed)
+	assert.LessOrEqual(t, doer.maxSeen, 2)
+}
+
+func TestMatchClient_MatchStreamCancel(t *testing.T) {
+	t.Parallel()
+	doer := &matchDoer{}
+	ids := make([]string, 20)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newMatchClient(doer).MatchStream(ctx, ids, 1)
+	<-stream
+	cancel()
+	timeout := time.After(time.Second)
+	for done := false; !done; {
+		select {
+		case _, ok := <-stream:
+			done = !ok
+		case <-timeout:
+			require.FailNow(t, "stream was not closed after cancellation")
+		}
+	}
+	doer.mu.Lock()
+	defer doer.mu.Unlock()
+	assert.Less(t, doer.requests, len(ids))
+}
+
+func TestMatchClient_MatchHistoryStream(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	client := newMatchClient(&matchDoer{})
+	var got []string
+	options := &MatchListOptions{Queues: []string{QueueCompetitive}}
+	for value := range client.MatchHistoryStream(ctx, "puuid", options, 0) {
+		require.NoError(t, value.Error)
+		got = append(got, value.Match.MatchInfo.MatchID)
+	}
+	sort.Strings(got)
+	assert.Equal(t, []string{"m1", "m3"}, got)
+
+	var errs []error
+	client = newMatchClient(mock.NewStatusMockDoer(http.StatusNotFound))
+	for value := range client.MatchHistoryStream(ctx, "puuid", nil, 0) {
+		errs = append(errs, value.Error)
+	}
+	assert.Equal(t, []error{api.ErrNotFound}, errs)
+}
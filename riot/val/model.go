@@ -5,6 +5,10 @@ type Act struct {
 	Name     string `json:"name"`
 	ID       string `json:"id"`
 	IsActive bool   `json:"isActive"`
+	// ParentID is the ID of the episode an act belongs to
+	ParentID string `json:"parentId"`
+	// Type is either ActTypeAct or ActTypeEpisode
+	Type string `json:"type"`
 }
 
 // ContentItem represents an individual content in ContentInfo
@@ -75,12 +79,13 @@ type PlatformData struct {
 
 // Player holds data of individual players in a leaderboard
 type Player struct {
-	PuuID           string `json:"puuid"`
-	GameName        string `json:"gameName"`
-	TagLine         string `json:"tagLine"`
-	LeaderboardRank int64  `json:"leaderboardRank"`
-	RankedRating    int64  `json:"rankedRating"`
-	NumberOfWins    int64  `json:"numberOfWins"`
+	PuuID           string          `json:"puuid"`
+	GameName        string          `json:"gameName"`
+	TagLine         string          `json:"tagLine"`
+	LeaderboardRank int64           `json:"leaderboardRank"`
+	RankedRating    int64           `json:"rankedRating"`
+	NumberOfWins    int64           `json:"numberOfWins"`
+	CompetitiveTier CompetitiveTier `json:"competitiveTier"`
 }
 
 // Leaderboard represents a leaderboard
@@ -117,16 +122,16 @@ type MatchInfo struct {
 
 // MatchPlayer holds data of a player participating a match
 type MatchPlayer struct {
-	PuuID           string      `json:"puuid"`
-	GameName        string      `json:"gameName"`
-	TagLine         string      `json:"tagLine"`
-	TeamID          string      `json:"teamId"`
-	PartyID         string      `json:"partyId"`
-	CharacterID     string      `json:"characterId"`
-	Stats           PlayerStats `json:"stats"`
-	CompetitiveTier int         `json:"competitiveTier"`
-	PlayerCard      string      `json:"playerCard"`
-	PlayerTitle     string      `json:"playerTitle"`
+	PuuID           string          `json:"puuid"`
+	GameName        string          `json:"gameName"`
+	TagLine         string          `json:"tagLine"`
+	TeamID          string          `json:"teamId"`
+	PartyID         string          `json:"partyId"`
+	CharacterID     string          `json:"characterId"`
+	Stats           PlayerStats     `json:"stats"`
+	CompetitiveTier CompetitiveTier `json:"competitiveTier"`
+	PlayerCard      string          `json:"playerCard"`
+	PlayerTitle     string          `json:"playerTitle"`
 }
 
 // PlayerStats stats of a player in a match
@@ -227,11 +232,11 @@ type FinishingDamage struct {
 // Damage contains information of a damage
 type Damage struct {
 	Receiver            string `json:"receiver"`
-	Damage              bool   `json:"damage"`
+	Damage              int    `json:"damage"`
 	IsSecondaryFireMode bool   `json:"isSecondaryFireMode"`
-	LegShots            bool   `json:"legshots"`
-	BodyShots           bool   `json:"bodyshots"`
-	Headshots           bool   `json:"headshots"`
+	LegShots            int    `json:"legshots"`
+	BodyShots           int    `json:"bodyshots"`
+	Headshots           int    `json:"headshots"`
 }
 
 // Economy holds economy information including spent credits
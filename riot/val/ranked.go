@@ -5,6 +5,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -23,7 +24,8 @@ func (cc *RankedClient) GetLeaderboardByActID(actID string, startIndex, size int
 	if size < 1 {
 		size = 200
 	}
-	if err := cc.c.GetInto(
+	c := cc.c.ForGame(api.GameVAL, api.FamilyPlatform)
+	if err := c.GetInto(
 		fmt.Sprintf(endpointGetLeaderboardByActID+"?size=%d&startIndex=%d", actID, size, startIndex), &leaderboard,
 	); err != nil {
 		logger.Debug(err)
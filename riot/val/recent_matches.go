@@ -0,0 +1,134 @@
+package val
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// NewMatchesFunc is called by a RecentMatchPoller with the IDs of the matches completed since the last poll
+type NewMatchesFunc func(queue string, matchIDs []string)
+
+// Esports returns a match client using the same configuration but sending requests to the esports region, which
+// provides recent matches of tournaments
+func (cc *MatchClient) Esports() *MatchClient {
+	c := *cc.c
+	c.Region = RegionESPORTS
+	return &MatchClient{c: &c}
+}
+
+// RecentMatchPoller periodically requests the recent matches of a set of queues and reports only the matches
+// which completed since the previous request. Responses with an unchanged current time are skipped.
+type RecentMatchPoller struct {
+	client    *MatchClient
+	queues    []string
+	interval  time.Duration
+	onMatches NewMatchesFunc
+	mu        sync.Mutex
+	// currentTime contains the current time of the last response per queue
+	currentTime map[string]int64
+	// seen contains the match IDs of the last response per queue
+	seen      map[string]map[string]bool
+	lastErr   error
+	stop      chan struct{}
+	startOnce sync.Once
+	stopOnce  sync.Once
+}
+
+// NewRecentMatchPoller returns a new poller for the given queues, which polls in the given interval once started.
+// The callback may be nil. Use Esports to poll the recent matches of tournaments.
+func (cc *MatchClient) NewRecentMatchPoller(
+	interval time.Duration, onMatches NewMatchesFunc, queues ...string,
+) *RecentMatchPoller {
+	return &RecentMatchPoller{
+		client:      cc,
+		queues:      queues,
+		interval:    interval,
+		onMatches:   onMatches,
+		currentTime: map[string]int64{},
+		seen:        map[string]map[string]bool{},
+		stop:        make(chan struct{}),
+	}
+}
+
+// Poll requests the recent matches of all queues and returns the IDs of the new matches per queue. Queues without
+// new matches are omitted. The first poll of a queue reports all of its recent matches.
+func (p *RecentMatchPoller) Poll() (map[string][]string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	res := map[string][]string{}
+	var errs []error
+	for _, queue := range p.queues {
+		recent, err := p.client.GetRecentMatchesByQueue(queue)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if matchIDs := p.update(queue, recent); len(matchIDs) > 0 {
+			res[queue] = matchIDs
+			if p.onMatches != nil {
+				p.onMatches(queue, matchIDs)
+			}
+		}
+	}
+	p.lastErr = errors.Join(errs...)
+	return res, p.lastErr
+}
+
+// update stores the response for the queue and returns the IDs of the matches not part of the previous response
+func (p *RecentMatchPoller) update(queue string, recent *RecentMatches) []string {
+	if last, ok := p.currentTime[queue]; ok && last == recent.CurrentTime {
+		return nil
+	}
+	p.currentTime[queue] = recent.CurrentTime
+	previous := p.seen[queue]
+	seen := make(map[string]bool, len(recent.MatchIDs))
+	var res []string
+	for _, matchID := range recent.MatchIDs {
+		seen[matchID] = true
+		if !previous[matchID] && !slices.Contains(res, matchID) {
+			res = append(res, matchID)
+		}
+	}
+	p.seen[queue] = seen
+	return res
+}
+
+// LastError returns the error of the last poll or nil if it succeeded
+func (p *RecentMatchPoller) LastError() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.lastErr
+}
+
+// Start starts polling in the background until Stop is called. Calling Start more than once has no effect.
+func (p *RecentMatchPoller) Start() {
+	p.startOnce.Do(
+		func() {
+			go p.run()
+		},
+	)
+}
+
+// Stop stops polling in the background
+func (p *RecentMatchPoller) Stop() {
+	p.stopOnce.Do(
+		func() {
+			close(p.stop)
+		},
+	)
+}
+
+func (p *RecentMatchPoller) run() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			_, _ = p.Poll()
+		}
+	}
+}
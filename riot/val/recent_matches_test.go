@@ -0,0 +1,121 @@
+package val
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// recentMatchesDoer serves the recent matches configured per queue and records the requested hosts
+type recentMatchesDoer struct {
+	mu     sync.Mutex
+	recent map[string]RecentMatches
+	hosts  []string
+}
+
+func (d *recentMatchesDoer) set(queue string, recent RecentMatches) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.recent[queue] = recent
+}
+
+func (d *recentMatchesDoer) Do(r *http.Request) (*http.Response, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.hosts = append(d.hosts, r.URL.Host)
+	queue := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+	recent, ok := d.recent[queue]
+	if !ok {
+		return mock.NewStatusMockDoer(http.StatusNotFound).Do(r)
+	}
+	return mock.NewJSONMockDoer(recent, http.StatusOK).Do(r)
+}
+
+func TestMatchClient_Esports(t *testing.T) {
+	t.Parallel()
+	doer := &recentMatchesDoer{recent: map[string]RecentMatches{QueueCompetitive: {}}}
+	client := newMatchClient(doer)
+	_, err := client.Esports().GetRecentMatchesByQueue(QueueCompetitive)
+	require.NoError(t, err)
+	_, err = client.GetRecentMatchesByQueue(QueueCompetitive)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"esports.api.riotgames.com", "eu.api.riotgames.com"}, doer.hosts)
+}
+
+func TestRecentMatchPoller_Poll(t *testing.T) {
+	t.Parallel()
+	doer := &recentMatchesDoer{
+		recent: map[string]RecentMatches{
+			QueueCompetitive: {CurrentTime: 1, MatchIDs: []string{"c1", "c2"}},
+			QueueUnrated:     {CurrentTime: 1, MatchIDs: []string{"u1"}},
+		},
+	}
+	var callbacks []string
+	poller := newMatchClient(doer).NewRecentMatchPoller(
+		time.Minute, func(queue string, matchIDs []string) {
+			callbacks = append(callbacks, queue+":"+strings.Join(matchIDs, ","))
+		}, QueueCompetitive, QueueUnrated,
+	)
+
+	got, err := poller.Poll()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{QueueCompetitive: {"c1", "c2"}, QueueUnrated: {"u1"}}, got)
+
+	// unchanged current time is skipped even if the match IDs changed
+	doer.set(QueueUnrated, RecentMatches{CurrentTime: 1, MatchIDs: []string{"u2"}})
+	doer.set(QueueCompetitive, RecentMatches{CurrentTime: 2, MatchIDs: []string{"c2", "c3"}})
+	got, err = poller.Poll()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{QueueCompetitive: {"c3"}}, got)
+
+	doer.set(QueueCompetitive, RecentMatches{CurrentTime: 3, MatchIDs: []string{"c3"}})
+	got, err = poller.Poll()
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	assert.Equal(t, []string{"competitive:c1,c2", "unrated:u1", "competitive:c3"}, callbacks)
+}
+
+func TestRecentMatchPoller_PollError(t *testing.T) {
+	t.Parallel()
+	doer := &recentMatchesDoer{
+		recent: map[string]RecentMatches{QueueCompetitive: {CurrentTime: 1, MatchIDs: []string{"c1"}}},
+	}
+	poller := newMatchClient(doer).NewRecentMatchPoller(time.Minute, nil, QueueCompetitive, QueueUnrated)
+	got, err := poller.Poll()
+	assert.ErrorIs(t, err, api.ErrNotFound)
+	assert.Equal(t, err, poller.LastError())
+	assert.Equal(t, map[string][]string{QueueCompetitive: {"c1"}}, got)
+}
+
+func TestRecentMatchPoller_Start(t *testing.T) {
+	t.Parallel()
+	doer := &recentMatchesDoer{
+		recent: map[string]RecentMatches{QueueCompetitive: {CurrentTime: 1, MatchIDs: []string{"c1"}}},
+	}
+	received := make(chan []string, 1)
+	poller := newMatchClient(doer).NewRecentMatchPoller(
+		time.Millisecond, func(_ string, matchIDs []string) {
+			received <- matchIDs
+		}, QueueCompetitive,
+	)
+	poller.Start()
+	poller.Start()
+	defer poller.Stop()
+	select {
+	case matchIDs := <-received:
+		assert.Equal(t, []string{"c1"}, matchIDs)
+	case <-time.After(time.Second):
+		t.Fatal("no matches received")
+	}
+	poller.Stop()
+	assert.NoError(t, poller.LastError())
+}
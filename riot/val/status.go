@@ -3,6 +3,7 @@ package val
 import (
 	log "github.com/sirupsen/logrus"
 
+	"github.com/KnutZuidema/golio/api"
 	"github.com/KnutZuidema/golio/internal"
 )
 
@@ -15,7 +16,8 @@ type StatusClient struct {
 func (cc *StatusClient) GetPlatformData() (*PlatformData, error) {
 	logger := cc.logger().WithField("method", "GetPlatformData")
 	var platformData *PlatformData
-	if err := cc.c.GetInto(endpointGetPlatformData, &platformData); err != nil {
+	c := cc.c.ForGame(api.GameVAL, api.FamilyPlatform)
+	if err := c.GetInto(endpointGetPlatformData, &platformData); err != nil {
 		logger.Debug(err)
 		return nil, err
 	}
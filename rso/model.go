@@ -0,0 +1,27 @@
+package rso
+
+import "time"
+
+// expiryDelta is subtracted from the expiry of a token so that it is not used right before it expires
+const expiryDelta = 10 * time.Second
+
+// Token is an RSO token set
+type Token struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	IDToken      string `json:"id_token"`
+	TokenType    string `json:"token_type"`
+	Scope        string `json:"scope"`
+	// Lifetime of the access token in seconds
+	ExpiresIn int64 `json:"expires_in"`
+	// Expiry is calculated from ExpiresIn when the token is received. The zero value means the token does not expire.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+// Valid returns true if the token has an access token which is not expired at the given time
+func (t *Token) Valid(now time.Time) bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || now.Before(t.Expiry.Add(-expiryDelta))
+}
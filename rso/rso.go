@@ -0,0 +1,151 @@
+// Package rso provides an OAuth2 helper for Riot Sign On (RSO). It builds authorization URLs, exchanges
+// authorization codes for tokens, refreshes them and provides a per-user client for the /me endpoints of the
+// Riot API which require the access token of a signed in player.
+package rso
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal"
+)
+
+const (
+	// DefaultAuthURL is the authorization endpoint of Riot Sign On
+	DefaultAuthURL = "https://auth.riotgames.com/authorize"
+	// DefaultTokenURL is the token endpoint of Riot Sign On
+	DefaultTokenURL = "https://auth.riotgames.com/token"
+	// ScopeOpenID is required for all RSO requests
+	ScopeOpenID = "openid"
+	// ScopeOfflineAccess is required to receive a refresh token
+	ScopeOfflineAccess = "offline_access"
+	// ScopeCPID adds the player's game region to the id token
+	ScopeCPID = "cpid"
+)
+
+// Config contains the credentials of a registered RSO client
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	// RedirectURI must match one of the redirect URIs registered for the client
+	RedirectURI string
+	// Scopes requested during authorization. Defaults to openid and offline_access.
+	Scopes []string
+	// AuthURL overrides DefaultAuthURL
+	AuthURL string
+	// TokenURL overrides DefaultTokenURL, e.g. to use a local fake token endpoint
+	TokenURL string
+}
+
+// Client provides methods for the RSO OAuth2 flow
+type Client struct {
+	config Config
+	client internal.Doer
+	now    func() time.Time
+}
+
+// NewClient returns a new RSO client for the given configuration
+func NewClient(config Config, client internal.Doer) *Client {
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{ScopeOpenID, ScopeOfflineAccess}
+	}
+	if config.AuthURL == "" {
+		config.AuthURL = DefaultAuthURL
+	}
+	if config.TokenURL == "" {
+		config.TokenURL = DefaultTokenURL
+	}
+	return &Client{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// AuthCodeURL returns the URL players are redirected to for signing in. The state is passed back to the
+// redirect URI unchanged and should be used to protect against CSRF.
+func (c *Client) AuthCodeURL(state string) string {
+	query := url.Values{
+		"client_id":     {c.config.ClientID},
+		"redirect_uri":  {c.config.RedirectURI},
+		"response_type": {"code"},
+		"scope":         {strings.Join(c.config.Scopes, " ")},
+	}
+	if state != "" {
+		query.Set("state", state)
+	}
+	separator := "?"
+	if strings.Contains(c.config.AuthURL, "?") {
+		separator = "&"
+	}
+	return c.config.AuthURL + separator + query.Encode()
+}
+
+// Exchange exchanges an authorization code received at the redirect URI for a token
+func (c *Client) Exchange(code string) (*Token, error) {
+	return c.requestToken(
+		url.Values{
+			"grant_type":   {"authorization_code"},
+			"code":         {code},
+			"redirect_uri": {c.config.RedirectURI},
+		},
+	)
+}
+
+// Refresh returns a new token for the given refresh token
+func (c *Client) Refresh(refreshToken string) (*Token, error) {
+	token, err := c.requestToken(
+		url.Values{
+			"grant_type":    {"refresh_token"},
+			"refresh_token": {refreshToken},
+			"scope":         {strings.Join(c.config.Scopes, " ")},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// the refresh token is not necessarily rotated
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+func (c *Client) requestToken(form url.Values) (*Token, error) {
+	request, err := http.NewRequest(http.MethodPost, c.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.SetBasicAuth(c.config.ClientID, c.config.ClientSecret)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("Accept", "application/json")
+	response, err := c.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	if response.Body != nil {
+		defer response.Body.Close()
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		err, ok := api.StatusToError[response.StatusCode]
+		if !ok {
+			err = api.Error{
+				Message:    api.ErrMsgUnknown,
+				StatusCode: response.StatusCode,
+			}
+		}
+		return nil, err
+	}
+	var token Token
+	if err := json.NewDecoder(response.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = c.now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return &token, nil
+}
@@ -0,0 +1,162 @@
+package rso
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/internal/mock"
+)
+
+// newTokenServer returns a fake token endpoint accepting the code "valid-code" and the refresh token
+// "valid-refresh"
+func newTokenServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				id, secret, ok := r.BasicAuth()
+				if !ok || id != "client-id" || secret != "client-secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				require.NoError(t, r.ParseForm())
+				token := Token{TokenType: "Bearer", ExpiresIn: 3600, Scope: r.PostForm.Get("scope")}
+				switch {
+				case r.PostForm.Get("grant_type") == "authorization_code" &&
+					r.PostForm.Get("code") == "valid-code" &&
+					r.PostForm.Get("redirect_uri") == "http://localhost/callback":
+					token.AccessToken = "access-1"
+					token.RefreshToken = "valid-refresh"
+				case r.PostForm.Get("grant_type") == "refresh_token" &&
+					r.PostForm.Get("refresh_token") == "valid-refresh":
+					token.AccessToken = "access-2"
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_ = json.NewEncoder(w).Encode(token)
+			},
+		),
+	)
+}
+
+func newTestClient(tokenURL string) *Client {
+	return NewClient(
+		Config{
+			ClientID:     "client-id",
+			ClientSecret: "client-secret",
+			RedirectURI:  "http://localhost/callback",
+			TokenURL:     tokenURL,
+		}, http.DefaultClient,
+	)
+}
+
+func TestClient_AuthCodeURL(t *testing.T) {
+	t.Parallel()
+	c := newTestClient("")
+	got, err := url.Parse(c.AuthCodeURL("state"))
+	require.NoError(t, err)
+	assert.Equal(t, "auth.riotgames.com", got.Host)
+	assert.Equal(t, "/authorize", got.Path)
+	assert.Equal(
+		t, url.Values{
+			"client_id":     {"client-id"},
+			"redirect_uri":  {"http://localhost/callback"},
+			"response_type": {"code"},
+			"scope":         {"openid offline_access"},
+			"state":         {"state"},
+		}, got.Query(),
+	)
+	c.config.AuthURL = "http://localhost/authorize?prompt=login"
+	assert.Contains(t, c.AuthCodeURL(""), "http://localhost/authorize?prompt=login&client_id=client-id")
+}
+
+func TestClient_Exchange(t *testing.T) {
+	t.Parallel()
+	server := newTokenServer(t)
+	defer server.Close()
+	c := newTestClient(server.URL)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	token, err := c.Exchange("valid-code")
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", token.AccessToken)
+	assert.Equal(t, "valid-refresh", token.RefreshToken)
+	assert.Equal(t, now.Add(time.Hour), token.Expiry)
+	assert.True(t, token.Valid(now))
+	assert.False(t, token.Valid(now.Add(time.Hour)))
+
+	_, err = c.Exchange("invalid-code")
+	assert.Equal(t, api.ErrBadRequest, err)
+	c.config.ClientSecret = "wrong"
+	_, err = c.Exchange("valid-code")
+	assert.Equal(t, api.ErrUnauthorized, err)
+}
+
+func TestClient_Refresh(t *testing.T) {
+	t.Parallel()
+	server := newTokenServer(t)
+	defer server.Close()
+	c := newTestClient(server.URL)
+	token, err := c.Refresh("valid-refresh")
+	require.NoError(t, err)
+	assert.Equal(t, "access-2", token.AccessToken)
+	assert.Equal(t, "valid-refresh", token.RefreshToken)
+	assert.Equal(t, "openid offline_access", token.Scope)
+	_, err = c.Refresh("invalid-refresh")
+	assert.Equal(t, api.ErrBadRequest, err)
+}
+
+func TestClient_requestTokenErrors(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		doer    *mock.Doer
+		wantErr error
+	}{
+		{
+			name: "request error",
+			doer: &mock.Doer{
+				Custom: func(r *http.Request) (*http.Response, error) {
+					return nil, fmt.Errorf("error")
+				},
+			},
+			wantErr: fmt.Errorf("error"),
+		},
+		{
+			name:    "unknown status",
+			doer:    mock.NewStatusMockDoer(999),
+			wantErr: api.Error{Message: api.ErrMsgUnknown, StatusCode: 999},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(
+			tt.name, func(t *testing.T) {
+				c := NewClient(Config{}, tt.doer)
+				_, err := c.Exchange("code")
+				assert.Equal(t, tt.wantErr, err)
+			},
+		)
+	}
+	c := NewClient(Config{}, mock.NewJSONMockDoer("not a token", 200))
+	_, err := c.Exchange("code")
+	assert.Error(t, err)
+}
+
+func TestClient_requestTokenClosesBody(t *testing.T) {
+	t.Parallel()
+	for _, code := range []int{http.StatusOK, http.StatusBadRequest} {
+		doer := mock.NewJSONMockDoer(Token{AccessToken: "access"}, code)
+		_, _ = NewClient(Config{}, doer).Exchange("code")
+		_, err := doer.ResponseBody.Read(make([]byte, 1))
+		assert.Equal(t, mock.ErrBodyClosed, err)
+	}
+}
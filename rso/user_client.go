@@ -0,0 +1,82 @@
+package rso
+
+import (
+	"sync"
+
+	"github.com/KnutZuidema/golio/riot"
+	"github.com/KnutZuidema/golio/riot/account"
+	"github.com/KnutZuidema/golio/riot/lol"
+	"github.com/KnutZuidema/golio/riot/tft"
+)
+
+// UserClient calls the /me endpoints of the Riot API on behalf of a single signed in player.
+// The access token is refreshed automatically once it expired.
+type UserClient struct {
+	rso  *Client
+	riot *riot.Client
+	mu   sync.Mutex
+	tok  *Token
+	// OnRefresh is called with the new token after each refresh, e.g. to persist it
+	OnRefresh func(token *Token)
+}
+
+// NewUserClient returns a client for the player the given token belongs to
+func (c *Client) NewUserClient(token *Token, riotClient *riot.Client) *UserClient {
+	return &UserClient{
+		rso:  c,
+		riot: riotClient,
+		tok:  token,
+	}
+}
+
+// Token returns a copy of the current token of the player
+func (u *UserClient) Token() Token {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return *u.tok
+}
+
+// AccessToken returns a valid access token, refreshing the token if necessary
+func (u *UserClient) AccessToken() (string, error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.tok.Valid(u.rso.now()) {
+		return u.tok.AccessToken, nil
+	}
+	token, err := u.rso.Refresh(u.tok.RefreshToken)
+	if err != nil {
+		return "", err
+	}
+	u.tok = token
+	if u.OnRefresh != nil {
+		u.OnRefresh(token)
+	}
+	return token.AccessToken, nil
+}
+
+// GetAccount returns the Riot account of the player
+func (u *UserClient) GetAccount() (*account.Account, error) {
+	accessToken, err := u.AccessToken()
+	if err != nil {
+		return nil, err
+	}
+	return u.riot.Account.GetMe(accessToken)
+}
+
+// GetLoLSummoner returns the League of Legends summoner of the player
+func (u *UserClient) GetLoLSummoner() (*lol.Summoner, error) {
+	accessToken, err := u.AccessToken()
+	if err != nil {
+		return nil, err
+	}
+	return u.riot.LoL.Summoner.GetMe(accessToken)
+}
+
+// GetTFTSummoner returns the Teamfight Tactics summoner of the player
+func (u *UserClient) GetTFTSummoner() (*tft.Summoner, error) {
+	accessToken, err := u.AccessToken()
+	if err != nil {
+		return nil, err
+	}
+	return u.riot.TFT.Summoner.GetSummonerByMe("Bearer " + accessToken)
+}
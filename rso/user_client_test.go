@@ -0,0 +1,78 @@
+package rso
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KnutZuidema/golio/api"
+	"github.com/KnutZuidema/golio/riot"
+)
+
+func TestUserClient(t *testing.T) {
+	t.Parallel()
+	tokenServer := newTokenServer(t)
+	defer tokenServer.Close()
+	var authorizations []string
+	apiServer := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				authorizations = append(authorizations, r.URL.Path+" "+r.Header.Get("Authorization"))
+				_ = json.NewEncoder(w).Encode(map[string]string{"puuid": "puuid"})
+			},
+		),
+	)
+	defer apiServer.Close()
+	riotClient := riot.NewClient(
+		api.RegionEuropeWest, "API_KEY", http.DefaultClient, log.StandardLogger(),
+		riot.WithBaseURL(apiServer.URL+"/{region}"),
+	)
+	c := newTestClient(tokenServer.URL)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	token, err := c.Exchange("valid-code")
+	require.NoError(t, err)
+	user := c.NewUserClient(token, riotClient)
+	var refreshed *Token
+	user.OnRefresh = func(token *Token) {
+		refreshed = token
+	}
+
+	acc, err := user.GetAccount()
+	require.NoError(t, err)
+	assert.Equal(t, "puuid", acc.Puuid)
+	now = now.Add(2 * time.Hour)
+	_, err = user.GetLoLSummoner()
+	require.NoError(t, err)
+	require.NotNil(t, refreshed)
+	assert.Equal(t, "access-2", user.Token().AccessToken)
+	_, err = user.GetTFTSummoner()
+	require.NoError(t, err)
+	assert.Equal(
+		t, []string{
+			"/europe/riot/account/v1/accounts/me Bearer access-1",
+			"/euw1/lol/summoner/v4/summoners/me Bearer access-2",
+			"/euw1/tft/summoner/v1/summoners/me Bearer access-2",
+		}, authorizations,
+	)
+}
+
+func TestUserClient_RefreshError(t *testing.T) {
+	t.Parallel()
+	tokenServer := newTokenServer(t)
+	defer tokenServer.Close()
+	c := newTestClient(tokenServer.URL)
+	user := c.NewUserClient(&Token{AccessToken: "expired", Expiry: time.Now(), RefreshToken: "invalid"}, nil)
+	_, err := user.GetAccount()
+	assert.Equal(t, api.ErrBadRequest, err)
+	_, err = user.GetLoLSummoner()
+	assert.Equal(t, api.ErrBadRequest, err)
+	_, err = user.GetTFTSummoner()
+	assert.Equal(t, api.ErrBadRequest, err)
+}
@@ -1,7 +1,10 @@
 package static
 
+// DefaultBaseURL is the base URL of Riot's static developer data
+const DefaultBaseURL = "https://static.developer.riotgames.com"
+
 const (
-	staticDataBaseURL           = "https://static.developer.riotgames.com/docs/lol"
+	staticDataBaseURL           = "/docs/lol"
 	staticDataEndpointSeasons   = staticDataBaseURL + "/seasons.json"
 	staticDataEndpointQueues    = staticDataBaseURL + "/queues.json"
 	staticDataEndpointMaps      = staticDataBaseURL + "/maps.json"
@@ -5,6 +5,7 @@ package static
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 	"sync"
 
 	"github.com/sirupsen/logrus"
@@ -18,12 +19,23 @@ import (
 type Client struct {
 	logger  logrus.FieldLogger
 	client  internal.Doer
+	baseURL string
 	mutexes map[string]*sync.RWMutex
 	cache   map[string]any
 }
 
+// Option is used to alter the attributes of a static data client
+type Option func(*Client)
+
+// WithBaseURL sets the base URL used for all requests instead of DefaultBaseURL, e.g. to use a caching proxy
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
 // NewClient returns a new client
-func NewClient(doer internal.Doer, logger logrus.FieldLogger) *Client {
+func NewClient(doer internal.Doer, logger logrus.FieldLogger, options ...Option) *Client {
 	mutexes := map[string]*sync.RWMutex{
 		"seasons":   {},
 		"queues":    {},
@@ -31,12 +43,17 @@ func NewClient(doer internal.Doer, logger logrus.FieldLogger) *Client {
 		"gameModes": {},
 		"gameTypes": {},
 	}
-	return &Client{
+	c := &Client{
 		logger:  logger,
 		client:  doer,
+		baseURL: DefaultBaseURL,
 		mutexes: mutexes,
 		cache:   map[string]any{},
 	}
+	for _, opt := range options {
+		opt(c)
+	}
+	return c
 }
 
 // GetSeasons returns static data for seasons
@@ -205,7 +222,10 @@ func (c *Client) ClearCaches() {
 }
 
 func (c *Client) getInto(endpoint string, target any) error {
-	req, _ := http.NewRequest(http.MethodGet, endpoint, nil)
+	req, err := http.NewRequest(http.MethodGet, c.baseURL+endpoint, nil)
+	if err != nil {
+		return err
+	}
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return err
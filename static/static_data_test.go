@@ -477,3 +477,18 @@ func TestClient_ClearCaches(t *testing.T) {
 	client := NewClient(http.DefaultClient, log.StandardLogger())
 	client.ClearCaches()
 }
+
+func TestWithBaseURL(t *testing.T) {
+	t.Parallel()
+	var requested string
+	doer := &mock.Doer{
+		Custom: func(r *http.Request) (*http.Response, error) {
+			requested = r.URL.String()
+			return mock.NewJSONMockDoer([]Season{}, 200).Do(r)
+		},
+	}
+	c := NewClient(doer, log.StandardLogger(), WithBaseURL("http://localhost:8080/static/"))
+	_, err := c.GetSeasons()
+	assert.Nil(t, err)
+	assert.Equal(t, "http://localhost:8080/static/docs/lol/seasons.json", requested)
+}